@@ -0,0 +1,62 @@
+package enum
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/xybor-x/enum/internal/core"
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// MapAlias maps alternative string representations to an enum value, then
+// returns the value. Aliases are accepted by FromString and all
+// deserialization functions (e.g. ScanSQL and UnmarshalJSON), but the
+// canonical string representation is always emitted.
+//
+//	var RoleAdmin = enum.MapAlias(enum.New[Role]("admin"), "administrator", "root")
+//
+// It panics if an alias is already mapped to any value, as a canonical string
+// or an alias, as Map does.
+//
+// Note that this function is not thread-safe and should only be called during
+// initialization or other safe execution points to avoid race conditions.
+func MapAlias[Enum any](value Enum, aliases ...string) Enum {
+	return mapAliases(value, "an alias", aliases)
+}
+
+// MapLegacyString maps former string representations to an enum value, e.g.
+// after a rename, then returns the value. Legacy strings are accepted by
+// FromString and all deserialization functions (e.g. ScanSQL and
+// UnmarshalJSON), but the canonical string representation is always emitted.
+//
+//	var RoleAdmin = enum.MapLegacyString(enum.New[Role]("admin"), "superuser")
+//
+// It panics if a legacy string is already mapped to any value, as Map does.
+//
+// Note that this function is not thread-safe and should only be called during
+// initialization or other safe execution points to avoid race conditions.
+func MapLegacyString[Enum any](value Enum, legacy ...string) Enum {
+	return mapAliases(value, "a legacy string", legacy)
+}
+
+// AliasesOf returns the aliases of an enum value, mapped by MapAlias,
+// MapLegacyString or the Alias option, in their mapping order. It returns nil
+// if the value has no alias.
+func AliasesOf[Enum any](value Enum) []string {
+	return slices.Clone(registry.Get(mtkey.Enum2Aliases(value)))
+}
+
+func mapAliases[Enum any](value Enum, kind string, aliases []string) Enum {
+	if !IsValid(value) {
+		panic(fmt.Sprintf("enum %s (%#v): cannot map %s to an invalid value", TrueNameOf[Enum](), value, kind))
+	}
+
+	core.CheckMutable[Enum](core.ValueMutation, "map "+kind)
+
+	for _, alias := range aliases {
+		core.MapAlias(value, alias)
+	}
+
+	return value
+}
@@ -0,0 +1,445 @@
+// Package analyzer reports mistakes in the use of github.com/xybor-x/enum
+// which can only be found at runtime otherwise:
+//
+//   - a constant of an enum type which is never mapped by enum.Map, e.g. a
+//     RoleModerator constant added without its enum.Map call;
+//   - a switch statement over an enum type which doesn't cover all its mapped
+//     values, and has no default clause.
+//
+// The enum types and their values are those passed to the mapping functions
+// (enum.Map, enum.New, ...) of the analyzed packages. Switches over enum types
+// of imported packages are checked too.
+//
+// Run it with go vet:
+//
+//	go install github.com/xybor-x/enum/analyzer/cmd/enumcheck@latest
+//	go vet -vettool=$(which enumcheck) ./...
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const enumPath = "github.com/xybor-x/enum"
+
+// Analyzer reports unmapped constants and non-exhaustive switches of enum
+// types.
+var Analyzer = &analysis.Analyzer{
+	Name:      "enumcheck",
+	Doc:       "report unmapped enum constants and switches missing enum values",
+	URL:       "https://pkg.go.dev/github.com/xybor-x/enum/analyzer",
+	Requires:  []*analysis.Analyzer{inspect.Analyzer},
+	FactTypes: []analysis.Fact{new(enumValuesFact)},
+	Run:       run,
+}
+
+// valueRef refers to a package-level constant or variable holding an enum
+// value.
+type valueRef struct {
+	Pkg  string
+	Name string
+
+	// Value is the exact value of a constant, empty for a variable.
+	Value string
+}
+
+// enumValuesFact is the mapped values of the enum types mapped in a package,
+// keyed by the type string of the enum types.
+type enumValuesFact struct {
+	Types map[string][]valueRef
+}
+
+func (*enumValuesFact) AFact() {}
+
+func (f *enumValuesFact) String() string {
+	keys := make([]string, 0, len(f.Types))
+	for key := range f.Types {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return "enumValues(" + strings.Join(keys, ", ") + ")"
+}
+
+// mapFuncs are the functions of the enum package whose first argument is an
+// enum value, and which return it.
+var mapFuncs = map[string]bool{
+	"Map":             true,
+	"MapOpts":         true,
+	"MapPair":         true,
+	"MapDesc":         true,
+	"MapAlias":        true,
+	"MapLegacyString": true,
+}
+
+// newFuncs are the functions of the enum package which create an enum value of
+// their type argument.
+var newFuncs = map[string]bool{
+	"New":         true,
+	"NewOpts":     true,
+	"NewExtended": true,
+}
+
+type enumType struct {
+	typ    types.Type
+	values []valueRef
+
+	// byConst is true if a constant of the type is mapped, so all constants
+	// of the type are expected to be mapped.
+	byConst bool
+}
+
+type state struct {
+	pass  *analysis.Pass
+	types []*enumType
+}
+
+func (s *state) lookup(typ types.Type) *enumType {
+	for _, t := range s.types {
+		if types.Identical(t.typ, typ) {
+			return t
+		}
+	}
+
+	return nil
+}
+
+func (s *state) add(typ types.Type, ref valueRef, byConst bool) {
+	t := s.lookup(typ)
+	if t == nil {
+		t = &enumType{typ: typ}
+		s.types = append(s.types, t)
+	}
+
+	t.byConst = t.byConst || byConst
+	for _, v := range t.values {
+		if v == ref {
+			return
+		}
+	}
+
+	t.values = append(t.values, ref)
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	s := &state{pass: pass}
+
+	// Collect the mapped values of this package.
+	insp.Preorder([]ast.Node{(*ast.CallExpr)(nil), (*ast.ValueSpec)(nil), (*ast.AssignStmt)(nil)}, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			s.collectMapCall(n)
+		case *ast.ValueSpec:
+			for i, name := range n.Names {
+				if i < len(n.Values) {
+					s.collectNewValue(name, n.Values[i])
+				}
+			}
+		case *ast.AssignStmt:
+			if len(n.Lhs) != len(n.Rhs) {
+				return
+			}
+
+			for i, lhs := range n.Lhs {
+				if name, ok := lhs.(*ast.Ident); ok {
+					s.collectNewValue(name, n.Rhs[i])
+				}
+			}
+		}
+	})
+
+	if len(s.types) > 0 {
+		fact := &enumValuesFact{Types: map[string][]valueRef{}}
+		for _, t := range s.types {
+			fact.Types[typeKey(t.typ)] = t.values
+		}
+		pass.ExportPackageFact(fact)
+	}
+
+	s.checkUnmappedConsts()
+
+	// Add the enum types of the imported packages, for the switches.
+	for _, f := range pass.AllPackageFacts() {
+		fact, ok := f.Fact.(*enumValuesFact)
+		if !ok || f.Package == pass.Pkg {
+			continue
+		}
+
+		for key, values := range fact.Types {
+			for _, ref := range values {
+				s.addImported(key, ref)
+			}
+		}
+	}
+
+	insp.Preorder([]ast.Node{(*ast.SwitchStmt)(nil)}, func(n ast.Node) {
+		s.checkSwitch(n.(*ast.SwitchStmt))
+	})
+
+	return nil, nil
+}
+
+// enumFunc returns the name of the function of the enum package called by
+// call, if any.
+func (s *state) enumFunc(call *ast.CallExpr) string {
+	fun := call.Fun
+	switch f := fun.(type) {
+	case *ast.IndexExpr:
+		fun = f.X
+	case *ast.IndexListExpr:
+		fun = f.X
+	}
+
+	var ident *ast.Ident
+	switch f := fun.(type) {
+	case *ast.SelectorExpr:
+		ident = f.Sel
+	case *ast.Ident:
+		ident = f
+	default:
+		return ""
+	}
+
+	obj, ok := s.pass.TypesInfo.Uses[ident].(*types.Func)
+	if !ok || obj.Pkg() == nil || obj.Pkg().Path() != enumPath {
+		return ""
+	}
+
+	return obj.Name()
+}
+
+// collectMapCall collects the constant or variable passed to a mapping
+// function.
+func (s *state) collectMapCall(call *ast.CallExpr) {
+	if !mapFuncs[s.enumFunc(call)] || len(call.Args) == 0 {
+		return
+	}
+
+	ident, ok := astutil.Unparen(call.Args[0]).(*ast.Ident)
+	if !ok {
+		return
+	}
+
+	if ref, typ, isConst, ok := s.refOf(ident); ok {
+		s.add(typ, ref, isConst)
+	}
+}
+
+// collectNewValue collects the variable assigned the result of a creating
+// function, possibly wrapped in mapping functions, e.g.
+// enum.MapDesc(enum.New[Role]("admin"), "...").
+func (s *state) collectNewValue(name *ast.Ident, value ast.Expr) {
+	for {
+		call, ok := astutil.Unparen(value).(*ast.CallExpr)
+		if !ok {
+			return
+		}
+
+		fn := s.enumFunc(call)
+		if newFuncs[fn] {
+			break
+		}
+
+		if !mapFuncs[fn] || len(call.Args) == 0 {
+			return
+		}
+
+		value = call.Args[0]
+	}
+
+	if ref, typ, _, ok := s.refOf(name); ok {
+		s.add(typ, ref, false)
+	}
+}
+
+// refOf returns the reference to the package-level constant or variable
+// denoted by ident.
+func (s *state) refOf(ident *ast.Ident) (ref valueRef, typ types.Type, isConst bool, ok bool) {
+	obj := s.pass.TypesInfo.ObjectOf(ident)
+	if obj == nil || obj.Pkg() == nil || obj.Name() == "_" || obj.Parent() != obj.Pkg().Scope() {
+		return valueRef{}, nil, false, false
+	}
+
+	ref = valueRef{Pkg: obj.Pkg().Path(), Name: obj.Name()}
+	switch obj := obj.(type) {
+	case *types.Const:
+		ref.Value = obj.Val().ExactString()
+		return ref, obj.Type(), true, true
+	case *types.Var:
+		return ref, obj.Type(), false, true
+	default:
+		return valueRef{}, nil, false, false
+	}
+}
+
+// typeKey identifies the enum type across packages, regardless of the aliases
+// used to refer to it.
+func typeKey(typ types.Type) string {
+	return types.TypeString(types.Unalias(typ), nil)
+}
+
+func (s *state) addImported(key string, ref valueRef) {
+	for _, t := range s.types {
+		if typeKey(t.typ) == key {
+			s.add(t.typ, ref, false)
+			return
+		}
+	}
+
+	typ := s.importedType(key, ref)
+	if typ != nil {
+		s.add(typ, ref, false)
+	}
+}
+
+// importedType returns the type of the value referred by ref, if its package is
+// known by the analyzed package.
+func (s *state) importedType(key string, ref valueRef) types.Type {
+	var find func(pkgs []*types.Package, seen map[*types.Package]bool) types.Type
+	find = func(pkgs []*types.Package, seen map[*types.Package]bool) types.Type {
+		for _, pkg := range pkgs {
+			if seen[pkg] {
+				continue
+			}
+			seen[pkg] = true
+
+			if pkg.Path() == ref.Pkg {
+				if obj := pkg.Scope().Lookup(ref.Name); obj != nil && typeKey(obj.Type()) == key {
+					return obj.Type()
+				}
+
+				return nil
+			}
+
+			if typ := find(pkg.Imports(), seen); typ != nil {
+				return typ
+			}
+		}
+
+		return nil
+	}
+
+	return find(s.pass.Pkg.Imports(), map[*types.Package]bool{})
+}
+
+// checkUnmappedConsts reports the package-level constants of the enum types
+// mapped by constant, whose value is not mapped.
+func (s *state) checkUnmappedConsts() {
+	scope := s.pass.Pkg.Scope()
+	for _, name := range scope.Names() {
+		obj, ok := scope.Lookup(name).(*types.Const)
+		if !ok {
+			continue
+		}
+
+		t := s.lookup(obj.Type())
+		if t == nil || !t.byConst || t.hasConst(obj.Val()) {
+			continue
+		}
+
+		s.pass.Reportf(obj.Pos(), "constant %s of enum type %s is not mapped",
+			obj.Name(), types.TypeString(t.typ, types.RelativeTo(s.pass.Pkg)))
+	}
+}
+
+func (t *enumType) hasConst(value constant.Value) bool {
+	for _, ref := range t.values {
+		if ref.Value != "" && ref.Value == value.ExactString() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkSwitch reports the switch over an enum type which has no default clause
+// and doesn't cover all mapped values.
+func (s *state) checkSwitch(stmt *ast.SwitchStmt) {
+	if stmt.Tag == nil {
+		return
+	}
+
+	tv, ok := s.pass.TypesInfo.Types[stmt.Tag]
+	if !ok {
+		return
+	}
+
+	t := s.lookup(tv.Type)
+	if t == nil {
+		return
+	}
+
+	covered := map[valueRef]bool{}
+	for _, clause := range stmt.Body.List {
+		clause := clause.(*ast.CaseClause)
+		if clause.List == nil {
+			return // default
+		}
+
+		for _, expr := range clause.List {
+			s.cover(t, expr, covered)
+		}
+	}
+
+	var missing []string
+	for _, ref := range t.values {
+		if !covered[ref] {
+			missing = append(missing, s.nameOf(ref))
+		}
+	}
+
+	if len(missing) > 0 {
+		s.pass.Reportf(stmt.Pos(), "missing cases in switch of enum type %s: %s",
+			types.TypeString(t.typ, types.RelativeTo(s.pass.Pkg)), strings.Join(missing, ", "))
+	}
+}
+
+// cover marks the values of t denoted by the case expression.
+func (s *state) cover(t *enumType, expr ast.Expr, covered map[valueRef]bool) {
+	var ident *ast.Ident
+	switch e := astutil.Unparen(expr).(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	}
+
+	if ident != nil {
+		if obj := s.pass.TypesInfo.ObjectOf(ident); obj != nil && obj.Pkg() != nil {
+			covered[valueRef{Pkg: obj.Pkg().Path(), Name: obj.Name()}] = true
+		}
+	}
+
+	// Constant cases cover the constants of the same value.
+	if tv, ok := s.pass.TypesInfo.Types[expr]; ok && tv.Value != nil {
+		for _, ref := range t.values {
+			if ref.Value != "" && ref.Value == tv.Value.ExactString() {
+				covered[ref] = true
+			}
+		}
+	}
+
+	for _, ref := range t.values {
+		if covered[valueRef{Pkg: ref.Pkg, Name: ref.Name}] {
+			covered[ref] = true
+		}
+	}
+}
+
+func (s *state) nameOf(ref valueRef) string {
+	if ref.Pkg == s.pass.Pkg.Path() {
+		return ref.Name
+	}
+
+	return ref.Pkg[strings.LastIndex(ref.Pkg, "/")+1:] + "." + ref.Name
+}
@@ -0,0 +1,12 @@
+package analyzer_test
+
+import (
+	"testing"
+
+	"github.com/xybor-x/enum/analyzer"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), analyzer.Analyzer, "roles", "consumer")
+}
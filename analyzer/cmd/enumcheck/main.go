@@ -0,0 +1,14 @@
+// Command enumcheck runs the analyzer of github.com/xybor-x/enum/analyzer,
+// standalone or as a go vet tool:
+//
+//	go vet -vettool=$(which enumcheck) ./...
+package main
+
+import (
+	"github.com/xybor-x/enum/analyzer"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(analyzer.Analyzer)
+}
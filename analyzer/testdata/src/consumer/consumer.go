@@ -0,0 +1,26 @@
+package consumer
+
+import "roles"
+
+func describe(r roles.Role) string {
+	switch r { // want `missing cases in switch of enum type roles.Role: roles.RoleUser`
+	case roles.RoleAdmin:
+		return "admin"
+	}
+
+	switch r {
+	case roles.RoleUser, roles.RoleAdmin:
+		return "known"
+	}
+
+	return ""
+}
+
+func describeLevel(l roles.Level) string {
+	switch l {
+	case roles.LevelLow, roles.LevelHigh:
+		return "known"
+	}
+
+	return ""
+}
@@ -0,0 +1,13 @@
+// Package enum is a stub of github.com/xybor-x/enum for the analyzer tests.
+package enum
+
+type WrapEnum[underlyingEnum any] int
+
+func Map[Enum any](value Enum, reprs ...any) Enum { return value }
+
+func MapDesc[Enum any](value Enum, desc string) Enum { return value }
+
+func New[Enum any](reprs ...any) Enum {
+	var value Enum
+	return value
+}
@@ -0,0 +1,78 @@
+package roles // want package:`enumValues\(github.com/xybor-x/enum.WrapEnum\[roles.status\], roles.Level, roles.Role\)`
+
+import "github.com/xybor-x/enum"
+
+type Role int
+
+const (
+	RoleUser Role = iota
+	RoleAdmin
+	RoleModerator // want `constant RoleModerator of enum type Role is not mapped`
+)
+
+// RoleDefault has the value of a mapped constant.
+const RoleDefault = RoleUser
+
+var (
+	_ = enum.Map(RoleUser, "user")
+	_ = enum.Map(RoleAdmin, "admin")
+)
+
+type status any
+
+type Status = enum.WrapEnum[status]
+
+const (
+	StatusActive Status = iota
+	StatusClosed
+	StatusArchived // want `constant StatusArchived of enum type Status is not mapped`
+)
+
+func init() {
+	enum.Map(StatusActive, "active")
+	enum.Map(StatusClosed, "closed")
+}
+
+type Level int
+
+var (
+	LevelLow  = enum.New[Level]("low")
+	LevelHigh = enum.MapDesc(enum.New[Level]("high"), "needs attention")
+)
+
+func describe(r Role) string {
+	switch r { // want `missing cases in switch of enum type Role: RoleAdmin`
+	case RoleUser:
+		return "user"
+	}
+
+	switch r {
+	case RoleDefault, RoleAdmin:
+		return "known"
+	}
+
+	switch r {
+	case RoleUser:
+		return "user"
+	default:
+		return "other"
+	}
+}
+
+func describeStatus(s Status) string {
+	switch s { // want `missing cases in switch of enum type Status: StatusClosed`
+	case StatusActive:
+		return "active"
+	}
+
+	return ""
+}
+
+func describeLevel(l Level) string {
+	switch l { // want `missing cases in switch of enum type Level: LevelHigh`
+	case LevelLow:
+		return "low"
+	}
+
+	return ""
+}
@@ -0,0 +1,131 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// FromStrings converts the strings into enum values. The error joins the
+// errors of all invalid strings (see ParseString), each prefixed by its index:
+//
+//	index 2: enum Role: unknown string moderator (allowed values: user, admin)
+//
+// Invalid strings are converted into the zero value.
+func FromStrings[Enum any](strs []string) ([]Enum, error) {
+	values := make([]Enum, len(strs))
+
+	var errs []error
+	for i, s := range strs {
+		var err error
+		if values[i], err = ParseString[Enum](s); err != nil {
+			errs = append(errs, fmt.Errorf("index %d: %w", i, err))
+		}
+	}
+
+	return values, errors.Join(errs...)
+}
+
+// ToStrings converts the enum values into their string representations. The
+// error joins the errors of all invalid values (see ToStringSafe), each
+// prefixed by its index. Invalid values are converted into an empty string.
+func ToStrings[Enum any](values []Enum) ([]string, error) {
+	strs := make([]string, len(values))
+
+	var errs []error
+	for i, value := range values {
+		var err error
+		if strs[i], err = ToStringSafe(value); err != nil {
+			errs = append(errs, fmt.Errorf("index %d: %w", i, err))
+		}
+	}
+
+	return strs, errors.Join(errs...)
+}
+
+// MustFromStrings is similar to FromStrings, but panics with the error
+// message if any string is invalid.
+func MustFromStrings[Enum any](strs []string) []Enum {
+	return MustOK(FromStrings[Enum](strs))
+}
+
+// MustToStrings is similar to ToStrings, but panics with the error message if
+// any value is invalid.
+func MustToStrings[Enum any](values []Enum) []string {
+	return MustOK(ToStrings(values))
+}
+
+// ParseList parses a list of string representations separated by sep, e.g.
+// "admin, user" from a query string or an environment variable. Tokens are
+// trimmed and empty tokens are skipped, then resolved by ParseString (so
+// SetParseOptions applies). The error joins the errors of all unknown tokens,
+// each prefixed by its position in the list, starting at 0:
+//
+//	token 1: enum Role: unknown string moderator (allowed values: user, admin)
+func ParseList[Enum any](s, sep string) ([]Enum, error) {
+	values := []Enum{}
+	if s == "" {
+		return values, nil
+	}
+
+	var errs []error
+	for i, token := range strings.Split(s, sep) {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+
+		value, err := ParseString[Enum](token)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("token %d: %w", i, err))
+			continue
+		}
+
+		values = append(values, value)
+	}
+
+	return values, errors.Join(errs...)
+}
+
+// FormatList formats the enum values as a list of their string
+// representations separated by sep, the reverse of ParseList. It is the same
+// as JoinValues.
+func FormatList[Enum any](values []Enum, sep string) (string, error) {
+	return JoinValues(values, sep)
+}
+
+// Join returns the string representations of all enum values of a specific
+// type separated by sep, in the same order as All, e.g. "user, admin" for a
+// usage string. The allowed values of a ParseError are listed the same way.
+func Join[Enum any](sep string) string {
+	return strings.Join(registry.Get(mtkey.AllStrings[Enum]()), sep)
+}
+
+// JoinValues returns the string representations of the enum values separated
+// by sep. The error joins the errors of all invalid values, each prefixed by
+// its index, as ToStrings.
+func JoinValues[Enum any](values []Enum, sep string) (string, error) {
+	var b strings.Builder
+	var errs []error
+	for i, value := range values {
+		str, err := ToStringSafe(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("index %d: %w", i, err))
+			continue
+		}
+
+		if i > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(str)
+	}
+
+	if len(errs) > 0 {
+		return "", errors.Join(errs...)
+	}
+
+	return b.String(), nil
+}
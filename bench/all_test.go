@@ -0,0 +1,22 @@
+package bench_test
+
+import (
+	"testing"
+
+	"github.com/xybor-x/enum"
+	"github.com/xybor-x/enum/bench"
+)
+
+func BenchmarkAll(b *testing.B) {
+	b.Run("All", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = enum.All[bench.XyborEnumType]()
+		}
+	})
+
+	b.Run("AllRef", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = enum.AllRef[bench.XyborEnumType]()
+		}
+	})
+}
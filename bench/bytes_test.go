@@ -0,0 +1,26 @@
+package bench_test
+
+import (
+	"testing"
+
+	"github.com/xybor-x/enum"
+	"github.com/xybor-x/enum/bench"
+)
+
+var bytesSink []byte
+
+func BenchmarkToBytes(b *testing.B) {
+	b.Run("ToString", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bytesSink = []byte(enum.ToString(bench.XyborEnumTypeT9))
+		}
+	})
+
+	b.Run("ToBytes", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bytesSink, _ = enum.To[[]byte](bench.XyborEnumTypeT9)
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package bench_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/xybor-x/enum"
+	"github.com/xybor-x/enum/bench"
+)
+
+// skewedInputs returns inputs where a few strings dominate, as in logs: t0
+// is 70% of the inputs, t1 20%, and t2 to t9 share the rest.
+func skewedInputs() []string {
+	r := rand.New(rand.NewSource(1))
+	inputs := make([]string, 1024)
+	for i := range inputs {
+		switch p := r.Intn(100); {
+		case p < 70:
+			inputs[i] = "t0"
+		case p < 90:
+			inputs[i] = "t1"
+		default:
+			inputs[i] = parallelNames[2+r.Intn(8)]
+		}
+	}
+
+	return inputs
+}
+
+func BenchmarkDecoderFromString(b *testing.B) {
+	enum.Finalize[bench.XyborEnumType]()
+	inputs := skewedInputs()
+
+	b.Run("FromString", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			enum.FromString[bench.XyborEnumType](inputs[i%len(inputs)])
+		}
+	})
+
+	b.Run("Decoder", func(b *testing.B) {
+		d, err := enum.NewDecoder[bench.XyborEnumType]()
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for i := 0; i < b.N; i++ {
+			d.FromString(inputs[i%len(inputs)])
+		}
+	})
+}
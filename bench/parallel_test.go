@@ -0,0 +1,105 @@
+package bench_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/xybor-x/enum"
+)
+
+type parallelType0 int
+type parallelType1 int
+type parallelType2 int
+type parallelType3 int
+type parallelType4 int
+type parallelType5 int
+type parallelType6 int
+type parallelType7 int
+
+var parallelNames = []string{"t0", "t1", "t2", "t3", "t4", "t5", "t6", "t7", "t8", "t9"}
+
+// parallelOps contains one operation per registered type, so the benchmarks
+// exercise lookups spread over many types, as in a real service.
+var parallelOps = []parallelOp{
+	newParallelOp[parallelType0](),
+	newParallelOp[parallelType1](),
+	newParallelOp[parallelType2](),
+	newParallelOp[parallelType3](),
+	newParallelOp[parallelType4](),
+	newParallelOp[parallelType5](),
+	newParallelOp[parallelType6](),
+	newParallelOp[parallelType7](),
+}
+
+type parallelOp struct {
+	fromString  func(s string)
+	toString    func(i int)
+	marshalJSON func(i int)
+	scan        func(s string)
+}
+
+func newParallelOp[T any]() parallelOp {
+	values := make([]T, len(parallelNames))
+	for i, name := range parallelNames {
+		values[i] = enum.New[T](name)
+	}
+	enum.Finalize[T]()
+
+	return parallelOp{
+		fromString: func(s string) {
+			if _, ok := enum.FromString[T](s); !ok {
+				panic("unknown string " + s)
+			}
+		},
+		toString: func(i int) {
+			_ = enum.ToString(values[i])
+		},
+		marshalJSON: func(i int) {
+			if _, err := json.Marshal(enum.Nullable[T]{Enum: values[i], Valid: true}); err != nil {
+				panic(err)
+			}
+		},
+		scan: func(s string) {
+			var v T
+			if err := enum.ScanSQL(s, &v); err != nil {
+				panic(err)
+			}
+		},
+	}
+}
+
+// runParallel runs the operation with the given numbers of goroutines. The
+// number of cores is controlled by the -cpu flag, e.g. -cpu 1,4,16,64.
+func runParallel(b *testing.B, op func(typ, value int)) {
+	for _, goroutines := range []int{1, 4, 16, 64} {
+		b.Run(fmt.Sprintf("goroutines=%d", goroutines), func(b *testing.B) {
+			// RunParallel starts parallelism*GOMAXPROCS goroutines.
+			b.SetParallelism((goroutines + runtime.GOMAXPROCS(0) - 1) / runtime.GOMAXPROCS(0))
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					op(i%len(parallelOps), i%len(parallelNames))
+					i++
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkParallelFromString(b *testing.B) {
+	runParallel(b, func(typ, value int) { parallelOps[typ].fromString(parallelNames[value]) })
+}
+
+func BenchmarkParallelToString(b *testing.B) {
+	runParallel(b, func(typ, value int) { parallelOps[typ].toString(value) })
+}
+
+func BenchmarkParallelMarshalJSON(b *testing.B) {
+	runParallel(b, func(typ, value int) { parallelOps[typ].marshalJSON(value) })
+}
+
+func BenchmarkParallelScan(b *testing.B) {
+	runParallel(b, func(typ, value int) { parallelOps[typ].scan(parallelNames[value]) })
+}
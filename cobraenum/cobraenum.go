@@ -0,0 +1,34 @@
+// Package cobraenum completes the enum types of github.com/xybor-x/enum in the
+// shell completions of spf13/cobra.
+//
+//	role := RoleUser
+//	cmd.Flags().Var(enum.Flag(&role), "role", "the role of the user")
+//	cmd.RegisterFlagCompletionFunc("role", cobraenum.CompletionFunc[Role]())
+//
+// enum.Flag implements pflag.Value, so it is used as is for the flag itself.
+package cobraenum
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/xybor-x/enum"
+)
+
+// CompletionFunc returns a cobra.CompletionFunc, for ValidArgsFunction or
+// RegisterFlagCompletionFunc, which completes the string representations of
+// all values of the enum type. Descriptions (see enum.MapDesc) are shown by
+// the shells supporting them.
+func CompletionFunc[Enum any]() cobra.CompletionFunc {
+	return func(*cobra.Command, []string, string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		values := enum.DescribedValues[Enum]()
+		completions := make([]cobra.Completion, len(values))
+		for i, value := range values {
+			if value.Desc == "" {
+				completions[i] = value.Name
+			} else {
+				completions[i] = cobra.CompletionWithDesc(value.Name, value.Desc)
+			}
+		}
+
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,48 @@
+package cobraenum_test
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+	"github.com/xybor-x/enum/cobraenum"
+)
+
+func TestPFlag(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+
+	role := RoleUser
+	fs.Var(enum.Flag(&role), "role", "the role of the user")
+
+	f := fs.Lookup("role")
+	assert.Equal(t, "Role", f.Value.Type())
+	assert.Equal(t, "user", f.DefValue)
+
+	assert.NoError(t, fs.Parse([]string{"--role", "admin"}))
+	assert.Equal(t, RoleAdmin, role)
+
+	err := fs.Parse([]string{"--role=guest"})
+	assert.ErrorContains(t, err, "enum Role: unknown string guest (allowed values: user, admin)")
+}
+
+func TestCompletionFunc(t *testing.T) {
+	type Role int
+
+	var (
+		_ = enum.New[Role]("user")
+		_ = enum.MapDesc(enum.New[Role]("admin"), "manages the users")
+	)
+
+	completions, directive := cobraenum.CompletionFunc[Role]()(&cobra.Command{}, nil, "")
+	assert.Equal(t, []cobra.Completion{"user", "admin\tmanages the users"}, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
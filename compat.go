@@ -0,0 +1,302 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/xybor-x/enum/internal/core"
+)
+
+// registryExportVersion is the version of the document of ExportRegistry.
+const registryExportVersion = 1
+
+type registryExport struct {
+	Version int          `json:"version"`
+	Types   []typeExport `json:"types"`
+}
+
+type typeExport struct {
+	// ID is the fully qualified Go type, which identifies the type between
+	// releases.
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	Values []valueExport `json:"values"`
+
+	PairFormat          bool `json:"pairFormat,omitempty"`
+	AcceptLegacyNumbers bool `json:"acceptLegacyNumbers,omitempty"`
+	JSONNumberFormat    bool `json:"jsonNumberFormat,omitempty"`
+	SQLNumberFormat     bool `json:"sqlNumberFormat,omitempty"`
+}
+
+type valueExport struct {
+	String string  `json:"string"`
+	Number float64 `json:"number"`
+	Pair   string  `json:"pair,omitempty"`
+}
+
+// ExportRegistry returns the state of all registered enum types as a
+// versioned JSON document: their values, the representations of each value
+// and the settings which affect the serialization. The document is
+// deterministic, so it can be committed as a golden file and given to
+// CompatCheck by the next release.
+func ExportRegistry() ([]byte, error) {
+	return json.MarshalIndent(exportRegistry(), "", "  ")
+}
+
+func exportRegistry() registryExport {
+	export := registryExport{Version: registryExportVersion, Types: []typeExport{}}
+
+	// Types declared in functions can't be told apart by their names, they
+	// are numbered in their registration order.
+	seen := make(map[string]int)
+
+	for _, info := range core.AllTypes() {
+		snapshot := info.Snapshot()
+
+		id := typeID(info.Type)
+		if seen[id]++; seen[id] > 1 {
+			id += "#" + strconv.Itoa(seen[id])
+		}
+
+		typ := typeExport{
+			ID:                  id,
+			Name:                info.Name,
+			Values:              []valueExport{},
+			PairFormat:          snapshot.PairFormat,
+			AcceptLegacyNumbers: snapshot.AcceptLegacyNumbers,
+			JSONNumberFormat:    snapshot.JSONNumberFormat,
+			SQLNumberFormat:     snapshot.SQLNumberFormat,
+		}
+
+		for _, v := range snapshot.Values {
+			value := valueExport{String: v.String, Number: v.Number}
+			if v.Pair != nil {
+				value.Pair = Pair(v.Pair[0], v.Pair[1]).String()
+			}
+
+			typ.Values = append(typ.Values, value)
+		}
+
+		sort.Slice(typ.Values, func(i, j int) bool {
+			if typ.Values[i].Number != typ.Values[j].Number {
+				return typ.Values[i].Number < typ.Values[j].Number
+			}
+
+			return typ.Values[i].String < typ.Values[j].String
+		})
+
+		export.Types = append(export.Types, typ)
+	}
+
+	sort.Slice(export.Types, func(i, j int) bool { return export.Types[i].ID < export.Types[j].ID })
+
+	return export
+}
+
+func typeID(typ reflect.Type) string {
+	return typ.PkgPath() + "." + typ.Name()
+}
+
+// ChangeKind classifies the changes between two registry exports.
+type ChangeKind uint
+
+const (
+	// ChangeAddition is a value added to an existing type.
+	ChangeAddition ChangeKind = 1 << iota
+
+	// ChangeRemoval is a removed value or type.
+	ChangeRemoval
+
+	// ChangeRename is a value whose number is kept, but whose string
+	// representation changed.
+	ChangeRename
+
+	// ChangeRenumbering is a value whose string representation is kept, but
+	// whose number or pair changed.
+	ChangeRenumbering
+
+	// ChangeFormat is a changed setting which affects the serialization,
+	// e.g. UsePairFormat.
+	ChangeFormat
+)
+
+// CompatPolicy configures which changes CompatCheck reports.
+type CompatPolicy struct {
+	// Breaking is the set of changes reported as violations.
+	Breaking ChangeKind
+}
+
+// DefaultCompatPolicy reports all changes except additions, which are
+// compatible with the data serialized by the previous release.
+func DefaultCompatPolicy() CompatPolicy {
+	return CompatPolicy{Breaking: ChangeRemoval | ChangeRename | ChangeRenumbering | ChangeFormat}
+}
+
+// Violation is a breaking change reported by CompatCheck.
+type Violation struct {
+	// Kind is the kind of change, zero if the old export is invalid.
+	Kind ChangeKind
+
+	// Type is the name of the enum type (see NameOf).
+	Type string
+
+	// Value is the string representation of the changed value in the old
+	// export (in the new registry for additions), empty if the change is
+	// about the type.
+	Value string
+
+	// Message describes the change.
+	Message string
+}
+
+func (v Violation) Error() string {
+	return v.Message
+}
+
+// CompatCheck compares the registered enum types with a document produced by
+// ExportRegistry, e.g. by the previous release, and returns the changes which
+// are breaking according to the policy. Types which don't exist in the old
+// document are ignored.
+//
+// An invalid document is reported as a single violation.
+func CompatCheck(old []byte, policy CompatPolicy) []Violation {
+	var oldExport registryExport
+	if err := json.Unmarshal(old, &oldExport); err != nil {
+		return []Violation{{Message: fmt.Sprintf("invalid registry export: %v", err)}}
+	}
+
+	if oldExport.Version != registryExportVersion {
+		return []Violation{{Message: fmt.Sprintf("invalid registry export: unsupported version %d", oldExport.Version)}}
+	}
+
+	newTypes := make(map[string]typeExport)
+	for _, typ := range exportRegistry().Types {
+		newTypes[typ.ID] = typ
+	}
+
+	var violations []Violation
+	report := func(kind ChangeKind, typ, value, format string, args ...any) {
+		if policy.Breaking&kind != 0 {
+			violations = append(violations, Violation{
+				Kind:    kind,
+				Type:    typ,
+				Value:   value,
+				Message: fmt.Sprintf("enum %s: ", typ) + fmt.Sprintf(format, args...),
+			})
+		}
+	}
+
+	for _, oldType := range oldExport.Types {
+		newType, ok := newTypes[oldType.ID]
+		if !ok {
+			report(ChangeRemoval, oldType.Name, "", "the type was removed")
+			continue
+		}
+
+		compareTypes(oldType, newType, report)
+	}
+
+	return violations
+}
+
+func compareTypes(
+	oldType, newType typeExport,
+	report func(kind ChangeKind, typ, value, format string, args ...any),
+) {
+	name := oldType.Name
+
+	oldByString := make(map[string]valueExport, len(oldType.Values))
+	oldByNumber := make(map[float64]valueExport, len(oldType.Values))
+	for _, v := range oldType.Values {
+		oldByString[v.String] = v
+		oldByNumber[v.Number] = v
+	}
+
+	newByString := make(map[string]valueExport, len(newType.Values))
+	newByNumber := make(map[float64]valueExport, len(newType.Values))
+	for _, v := range newType.Values {
+		newByString[v.String] = v
+		newByNumber[v.Number] = v
+	}
+
+	for _, o := range oldType.Values {
+		n, ok := newByString[o.String]
+		if !ok {
+			if renamed, ok := newByNumber[o.Number]; ok {
+				if _, existed := oldByString[renamed.String]; !existed {
+					report(ChangeRename, name, o.String, "value %s (%s) was renamed to %s",
+						o.String, formatExportNumber(o.Number), renamed.String)
+					continue
+				}
+			}
+
+			report(ChangeRemoval, name, o.String, "value %s (%s) was removed", o.String, formatExportNumber(o.Number))
+			continue
+		}
+
+		if n.Number != o.Number {
+			report(ChangeRenumbering, name, o.String, "value %s was renumbered from %s to %s",
+				o.String, formatExportNumber(o.Number), formatExportNumber(n.Number))
+		}
+
+		if n.Pair != o.Pair {
+			report(ChangeRenumbering, name, o.String, "pair of value %s changed from %s to %s",
+				o.String, formatExportPair(o.Pair), formatExportPair(n.Pair))
+		}
+	}
+
+	for _, n := range newType.Values {
+		if _, ok := oldByString[n.String]; ok {
+			continue
+		}
+
+		// The value was reported as renamed.
+		if o, ok := oldByNumber[n.Number]; ok {
+			if _, kept := newByString[o.String]; !kept {
+				continue
+			}
+		}
+
+		report(ChangeAddition, name, n.String, "value %s (%s) was added", n.String, formatExportNumber(n.Number))
+	}
+
+	if oldType.PairFormat != newType.PairFormat {
+		report(ChangeFormat, name, "", "pair format was %s", enabledOrDisabled(newType.PairFormat))
+	}
+
+	if oldType.AcceptLegacyNumbers != newType.AcceptLegacyNumbers {
+		report(ChangeFormat, name, "", "legacy numbers were %s", enabledOrDisabled(newType.AcceptLegacyNumbers))
+	}
+
+	if oldType.JSONNumberFormat != newType.JSONNumberFormat {
+		report(ChangeFormat, name, "", "JSON number format was %s", enabledOrDisabled(newType.JSONNumberFormat))
+	}
+
+	if oldType.SQLNumberFormat != newType.SQLNumberFormat {
+		report(ChangeFormat, name, "", "SQL number format was %s", enabledOrDisabled(newType.SQLNumberFormat))
+	}
+}
+
+func formatExportNumber(n float64) string {
+	return strconv.FormatFloat(n, 'g', -1, 64)
+}
+
+func formatExportPair(pair string) string {
+	if pair == "" {
+		return "none"
+	}
+
+	return pair
+}
+
+func enabledOrDisabled(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+
+	return "disabled"
+}
@@ -0,0 +1,106 @@
+package enum
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// SQLDialect is the SQL dialect of the statements generated by SQLCreateType
+// and SQLCheckConstraint.
+type SQLDialect int
+
+const (
+	// PostgresDialect is PostgreSQL.
+	PostgresDialect SQLDialect = iota
+
+	// MySQLDialect is MySQL and MariaDB, where backslashes are escaped in
+	// string literals.
+	MySQLDialect
+
+	// SQLiteDialect is SQLite.
+	SQLiteDialect
+)
+
+func (d SQLDialect) String() string {
+	switch d {
+	case PostgresDialect:
+		return "postgres"
+	case MySQLDialect:
+		return "mysql"
+	case SQLiteDialect:
+		return "sqlite"
+	default:
+		return fmt.Sprintf("SQLDialect(%d)", int(d))
+	}
+}
+
+// SQLCreateType returns the statement declaring the enum type as a database
+// type named name, with the string representations of all values in their
+// registration order, for example:
+//
+//	CREATE TYPE role_type AS ENUM ('user', 'admin')
+//
+// Only PostgreSQL supports enum types, use SQLCheckConstraint for other
+// dialects.
+func SQLCreateType[Enum any](name string, dialect SQLDialect) (string, error) {
+	if dialect != PostgresDialect {
+		return "", fmt.Errorf("enum %s: %s doesn't support enum types, use a check constraint",
+			TrueNameOf[Enum](), dialect)
+	}
+
+	return PostgresEnumType{Name: name, Labels: PostgresType[Enum]().Labels}.CreateTypeSQL(), nil
+}
+
+// SQLCheckConstraint returns a check constraint restricting the column to the
+// values of the enum type, in their registration order, for example:
+//
+//	CHECK (role IN ('user', 'admin'))
+//
+// The values are the numeric representations if SetSQLFormat is SQLNumber,
+// otherwise the string representations.
+func SQLCheckConstraint[Enum any](column string, dialect SQLDialect) string {
+	var values string
+	if registry.Get(mtkey.SQLNumberFormat[Enum]()) {
+		numbers := make([]string, 0, len(AllRef[Enum]()))
+		for _, e := range AllRef[Enum]() {
+			numbers = append(numbers, formatNumber(reprOf[float64](e)))
+		}
+
+		values = strings.Join(numbers, ", ")
+	} else {
+		values = quoteSQLStrings(PostgresType[Enum]().Labels, dialect)
+	}
+
+	return fmt.Sprintf("CHECK (%s IN (%s))", column, values)
+}
+
+// formatNumber formats the numeric representation of an enum value without
+// exponent, e.g. 1000000 rather than 1e+06.
+func formatNumber(n float64) string {
+	if n == math.Trunc(n) && n >= math.MinInt64 && n < math.MaxInt64 {
+		return strconv.FormatInt(int64(n), 10)
+	}
+
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+// quoteSQLStrings returns the comma-separated string literals of the dialect.
+func quoteSQLStrings(strs []string, dialect SQLDialect) string {
+	quoted := make([]string, len(strs))
+	for i, s := range strs {
+		if dialect == MySQLDialect {
+			// MySQL treats backslashes as escapes in string literals by
+			// default.
+			s = strings.ReplaceAll(s, `\`, `\\`)
+		}
+
+		quoted[i] = "'" + strings.ReplaceAll(s, "'", "''") + "'"
+	}
+
+	return strings.Join(quoted, ", ")
+}
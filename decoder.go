@@ -0,0 +1,68 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// decoderSlots is the number of cached inputs of a Decoder, a power of two.
+const decoderSlots = 4
+
+type decoderSlot[Enum any] struct {
+	input string
+	value Enum
+	ok    bool
+	used  bool
+}
+
+// Decoder resolves enum values from their string representations, caching
+// the latest inputs in front of the registry lookup. It speeds up hot paths
+// decoding the same few strings repeatedly, e.g. log ingestion.
+//
+// A Decoder is not safe for concurrent use, create one per goroutine (e.g. per
+// worker) instead of sharing it.
+type Decoder[Enum any] struct {
+	slots [decoderSlots]decoderSlot[Enum]
+}
+
+// NewDecoder creates a Decoder of the enum type. The enum type must be
+// finalized, so the cached results never become stale.
+func NewDecoder[Enum any]() (*Decoder[Enum], error) {
+	if !registry.Get(mtkey.IsFinalized[Enum]()) {
+		return nil, fmt.Errorf("enum %s: a decoder requires the enum to be finalized", TrueNameOf[Enum]())
+	}
+
+	return &Decoder[Enum]{}, nil
+}
+
+// FromString is similar to the FromString function, but returns the cached
+// result if the input was recently decoded.
+func (d *Decoder[Enum]) FromString(s string) (Enum, bool) {
+	slot := &d.slots[decoderSlotOf(s)]
+	if !slot.used || slot.input != s {
+		value, ok := lookupString[Enum](s)
+
+		// Don't retain the memory backing the input, e.g. a whole request.
+		*slot = decoderSlot[Enum]{input: strings.Clone(s), value: value, ok: ok, used: true}
+	}
+
+	if slot.ok {
+		markUsage(slot.value, usageResolved)
+	}
+
+	return slot.value, slot.ok
+}
+
+// decoderSlotOf maps the input to a cache slot, using the bytes which most
+// likely differ between string representations without hashing the whole
+// input.
+func decoderSlotOf(s string) int {
+	if len(s) == 0 {
+		return 0
+	}
+
+	return (len(s) + int(s[0]) + int(s[len(s)-1])) & (decoderSlots - 1)
+}
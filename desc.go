@@ -0,0 +1,70 @@
+package enum
+
+import (
+	"fmt"
+
+	"github.com/xybor-x/enum/internal/core"
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// MapDesc associates a human-readable description with an enum value, then
+// returns the value. The description is used by DescribedValues, SchemaOf, and
+// the error value listing (see SetErrorValueListing).
+//
+//	var RoleAdmin = enum.MapDesc(enum.New[Role]("admin"), "full administrative access")
+//
+// Descriptions can be added after the enum type is finalized, but not after it
+// is frozen (see Freeze).
+//
+// Note that this function is not thread-safe and should only be called during
+// initialization or other safe execution points to avoid race conditions.
+func MapDesc[Enum any](value Enum, desc string) Enum {
+	if !IsValid(value) {
+		panic(fmt.Sprintf("enum %s (%#v): cannot describe an invalid value", TrueNameOf[Enum](), value))
+	}
+
+	core.CheckMutable[Enum](core.MetadataMutation, "describe a value")
+	registry.Set(mtkey.Enum2Desc(value), desc)
+	return value
+}
+
+// DescOf returns the description of the enum value. It returns an empty string
+// if the value has no description.
+func DescOf[Enum any](value Enum) string {
+	return registry.Get(mtkey.Enum2Desc(value))
+}
+
+// DescribedValue is an enum value together with its string representation and
+// description, e.g. to render an option list.
+type DescribedValue[Enum any] struct {
+	Value Enum
+	Name  string
+	Desc  string
+}
+
+// String returns the name of the value followed by its description, if any.
+func (v DescribedValue[Enum]) String() string {
+	if v.Desc == "" {
+		return v.Name
+	}
+
+	return fmt.Sprintf("%s — %s", v.Name, v.Desc)
+}
+
+// DescribedValues returns all values of the enum type together with their
+// string representations and descriptions. Values without description have an
+// empty Desc.
+func DescribedValues[Enum any]() []DescribedValue[Enum] {
+	values := AllRef[Enum]()
+	described := make([]DescribedValue[Enum], len(values))
+	for i, value := range values {
+		described[i] = DescribedValue[Enum]{
+			Value: value,
+			Name:  reprOf[string](value),
+			Desc:  DescOf(value),
+		}
+	}
+
+	return described
+}
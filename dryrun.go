@@ -0,0 +1,49 @@
+package enum
+
+import "github.com/xybor-x/enum/internal/core"
+
+// Def is a prospective mapping of an enum value to its representations, with
+// the arguments of Map.
+type Def[Enum any] struct {
+	Value Enum
+	Reprs []any
+}
+
+// Conflict describes why a prospective mapping cannot be applied.
+type Conflict struct {
+	// Value is the value which cannot be mapped.
+	Value any
+
+	// Existing is the mapped value involved in the conflict (e.g. the value
+	// already mapped to the same string), or nil if the conflict doesn't
+	// involve any other value.
+	Existing any
+
+	// Reason is the message which Map would panic with.
+	Reason string
+}
+
+func (c Conflict) Error() string {
+	return c.Reason
+}
+
+// DryRunMap checks whether the candidates can be mapped, in order, as if they
+// were mapped by Map, against the current state of the enum type. It returns
+// all conflicts, including those between the candidates, without modifying
+// the enum type. A candidate with conflicts is not taken into account to
+// check the next candidates.
+func DryRunMap[Enum any](candidates []Def[Enum]) []Conflict {
+	values := make([]Enum, 0, len(candidates))
+	reprs := make([][]any, 0, len(candidates))
+	for _, c := range candidates {
+		values = append(values, c.Value)
+		reprs = append(reprs, c.Reprs)
+	}
+
+	var conflicts []Conflict
+	for _, c := range core.DryRunMapAny(values, reprs) {
+		conflicts = append(conflicts, Conflict(c))
+	}
+
+	return conflicts
+}
@@ -11,16 +11,24 @@
 package enum
 
 import (
+	"cmp"
 	"database/sql/driver"
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"io"
 	"math"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/xybor-x/enum/internal/core"
 	"github.com/xybor-x/enum/internal/mtkey"
-	"github.com/xybor-x/enum/internal/mtmap"
+	"github.com/xybor-x/enum/internal/xbson"
+	"github.com/xybor-x/enum/internal/xcbor"
 	"github.com/xybor-x/enum/internal/xreflect"
+	"github.com/xybor-x/enum/registry"
 	"gopkg.in/yaml.v3"
 )
 
@@ -45,6 +53,9 @@ type hookAfterEnum interface {
 //     type.
 //   - An enum cannot be mapped to multiple representations of the same type.
 //
+// The representations may also be options (see MapOpts), which are not
+// classified by their types. Mixing options and other representations panics.
+//
 // Note that this function is not thread-safe and should only be called during
 // initialization or other safe execution points to avoid race conditions.
 func Map[Enum any](enum Enum, reprs ...any) Enum {
@@ -76,6 +87,8 @@ func New[Enum any](reprs ...any) (enum Enum) {
 		}
 	}()
 
+	core.CheckOptions[Enum](reprs)
+
 	switch {
 	case xreflect.IsZeroImplement[Enum, newableEnum]():
 		return xreflect.ImplementZero[Enum, newableEnum]().newEnum(reprs).(Enum)
@@ -85,6 +98,7 @@ func New[Enum any](reprs ...any) (enum Enum) {
 		numericRepr := core.GetNumericRepresentation(reprs)
 		if numericRepr == nil {
 			numericRepr = core.GetAvailableEnumValue[Enum]()
+			return core.MapAnyAuto(xreflect.Convert[Enum](numericRepr), reprs)
 		}
 
 		return core.MapAny(xreflect.Convert[Enum](numericRepr), core.RemoveNumericRepresentation(reprs))
@@ -114,59 +128,217 @@ func New[Enum any](reprs ...any) (enum Enum) {
 //	type role any
 //	type Role struct { enum.SafeEnum[role] }
 //
+// The embedded enum may also be a pointer (*enum.SafeEnum[role]), or be nested
+// in other anonymous struct fields (up to a limited depth), for example:
+//
+//	type Base struct { enum.SafeEnum[role] }
+//	type Role struct { Base }
+//
 // Note that this function is not thread-safe and should only be called during
 // initialization or other safe execution points to avoid race conditions.
 func NewExtended[T newableEnum](reprs ...any) (enum T) {
+	// Check before mapping the embedded enum, so a finalized type doesn't
+	// leave the embedded enum partially mapped.
+	core.CheckMutable[T](core.ValueMutation, "map a value")
+	core.CheckOptions[T](reprs)
+
 	defer func() {
 		if hook, ok := any(enum).(hookAfterEnum); ok {
 			hook.hookAfter()
 		}
 	}()
 
-	var extendEnum T
-	extendEnumValue := reflect.ValueOf(&extendEnum).Elem()
+	var inspected []string
+	var candidates []extendedField
+	if typ := reflect.TypeOf(enum); typ.Kind() == reflect.Struct {
+		candidates = seekEmbeddedEnums(typ, nil, "", 0, &inspected)
+	}
+
+	switch len(candidates) {
+	case 0:
+		if len(inspected) == 0 {
+			inspected = append(inspected, "none")
+		}
+
+		panic(fmt.Sprintf("invalid enum type %s: NewExtended is only used to create an extended enum, "+
+			"otherwise use New or Map instead! (inspected fields: %s)", TrueNameOf[T](), strings.Join(inspected, ", ")))
+
+	case 1:
+		// Ok.
+
+	default:
+		paths := make([]string, 0, len(candidates))
+		for _, c := range candidates {
+			paths = append(paths, c.path)
+		}
+
+		panic(fmt.Sprintf("enum %s: NewExtended found multiple embedded enums (%s)",
+			TrueNameOf[T](), strings.Join(paths, ", ")))
+	}
+
+	autoNumbered := false
+	if core.GetNumericRepresentation(reprs) == nil {
+		if core.HasOptions(reprs) {
+			reprs = append(reprs, core.NumOption(core.GetAvailableEnumValue[T]()))
+		} else {
+			reprs = append(reprs, core.GetAvailableEnumValue[T]())
+		}
+		autoNumbered = true
+	}
+
+	// Walk to the embedded enumable field, allocating embedded pointers on the
+	// way, then init that field.
+	enumField := reflect.ValueOf(&enum).Elem()
+	for _, i := range candidates[0].index {
+		enumField = enumField.Field(i)
+		if enumField.Kind() == reflect.Pointer {
+			if enumField.IsNil() {
+				enumField.Set(reflect.New(enumField.Type().Elem()))
+			}
+			enumField = enumField.Elem()
+		}
+	}
+
+	newable := reflect.Zero(enumField.Type()).Interface().(newableEnum)
+	enumField.Set(reflect.ValueOf(newable.newEnum(reprs)))
+
+	// The newEnum method mapped the enum value to the system (see the
+	// description of the newEnum method). Why is MapAny called again here?
+	//
+	// The mapping in the newEnum method only applies the enum value to the
+	// embedded enum field type, not the extended enum type. To enable
+	// utility functions to work with the extended enum type, we need to map
+	// it again using MapAny.
+	if autoNumbered {
+		return core.MapAnyAuto(enum, reprs)
+	}
+
+	return core.MapAny(enum, reprs)
+}
+
+// maxExtendedDepth is the maximum depth of anonymous struct fields which
+// NewExtended seeks the embedded enum through.
+const maxExtendedDepth = 4
 
-	// Seek the embedded enumable field, then init that field.
-	for i := 0; i < extendEnumValue.NumField(); i++ {
-		fieldType := reflect.TypeOf(extendEnum).Field(i)
+var newableEnumType = reflect.TypeOf((*newableEnum)(nil)).Elem()
+
+// extendedField is an embedded enumable field found in an extended enum.
+type extendedField struct {
+	index []int
+	path  string
+}
+
+// seekEmbeddedEnums finds all embedded enumable fields of the struct type
+// recursively through anonymous fields. All fields which are rejected are
+// recorded into inspected with the reason.
+func seekEmbeddedEnums(typ reflect.Type, index []int, prefix string, depth int, inspected *[]string) []extendedField {
+	var found []extendedField
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		path := prefix + field.Name
+		fieldIndex := append(append([]int{}, index...), i)
 
 		// Ignore named fields.
-		if !fieldType.Anonymous {
+		if !field.Anonymous {
+			*inspected = append(*inspected, path+" (named)")
 			continue
 		}
 
 		// Ignore non-enumable fields.
-		if !fieldType.Type.Implements(reflect.TypeOf((*newableEnum)(nil)).Elem()) {
+		if !field.Type.Implements(newableEnumType) {
+			*inspected = append(*inspected, path+" (not enumable)")
 			continue
 		}
 
-		if core.GetNumericRepresentation(reprs) == nil {
-			reprs = append(reprs, core.GetAvailableEnumValue[T]())
+		// Unexported fields cannot be set.
+		if !field.IsExported() {
+			*inspected = append(*inspected, path+" (unexported)")
+			continue
+		}
+
+		elem := field.Type
+		if elem.Kind() == reflect.Pointer {
+			elem = elem.Elem()
 		}
 
-		// Set value to the embedded enumable field.
-		enumField := extendEnumValue.FieldByName(fieldType.Name)
-		enumField.Set(reflect.ValueOf(enumField.Interface().(newableEnum).newEnum(reprs)))
+		// The field inherits the enumable methods from its own embedded
+		// fields, seek deeper.
+		if elem.Kind() == reflect.Struct && hasEmbeddedEnum(elem) {
+			if depth+1 >= maxExtendedDepth {
+				*inspected = append(*inspected, path+" (too deep)")
+				continue
+			}
 
-		// The newEnum method mapped the enum value to the system (see the
-		// description of the newEnum method). Why is MapAny called again here?
-		//
-		// The mapping in the newEnum method only applies the enum value to the
-		// embedded enum field type, not the extended enum type. To enable
-		// utility functions to work with the extended enum type, we need to map
-		// it again using MapAny.
-		return core.MapAny(extendEnum, reprs)
+			found = append(found, seekEmbeddedEnums(elem, fieldIndex, path+".", depth+1, inspected)...)
+			continue
+		}
+
+		found = append(found, extendedField{index: fieldIndex, path: path})
 	}
 
-	panic("invalid enum type: NewExtended is only used to create an extended enum, otherwise use New or Map instead!")
+	return found
+}
+
+// hasEmbeddedEnum returns true if the struct type has any anonymous field
+// implementing newableEnum.
+func hasEmbeddedEnum(typ reflect.Type) bool {
+	for i := 0; i < typ.NumField(); i++ {
+		if field := typ.Field(i); field.Anonymous && field.Type.Implements(newableEnumType) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // Finalize prevents the creation of any new enum values for the current type.
+// Metadata, such as descriptions (see MapDesc), can still be added until the
+// type is frozen (see Freeze).
+//
+// The first call invokes the callbacks registered by OnFinalize and
+// OnAnyFinalize, subsequent calls do nothing.
 func Finalize[Enum any]() bool {
-	mtmap.Set(mtkey.IsFinalized[Enum](), true)
+	if registry.Get(mtkey.IsFinalized[Enum]()) {
+		return true
+	}
+
+	registry.Set(mtkey.IsFinalized[Enum](), true)
+	runFinalizeHooks[Enum]()
+	return true
+}
+
+// Freeze finalizes the enum type (see Finalize), then prevents any further
+// change to it, including metadata.
+//
+// The public mutators are classified as:
+//   - Value-affecting, blocked by Finalize and Freeze: Map, New, NewExtended,
+//     MapPair, SetStringMatcher, SetParseOptions, MapAlias,
+//     MapLegacyString.
+//   - Metadata-only, blocked by Freeze only: MapDesc, DeclareSubsetType,
+//     DefineTransitions, SetErrorValueListing, AcceptLegacyNumbers,
+//     SetJSONBMapLenient, UsePairFormat, RequireSingleNumberingStyle,
+//     SetMarshalInvalidAsNull, SetJSONNullIsError, SetJSONFormat,
+//     SetSQLFormat, SetGormDataType.
+//
+// OnFinalize and OnAnyFinalize are not affected, because they don't change
+// the enum type.
+func Freeze[Enum any]() bool {
+	Finalize[Enum]()
+	registry.Set(mtkey.IsFrozen[Enum](), true)
 	return true
 }
 
+// RequireSingleNumberingStyle forbids mixing auto-assigned numbers (e.g. New
+// without numeric representation) and explicit numbers (e.g. Map of a numeric
+// enum) in the enum type. The first registration mixing both styles panics.
+//
+// It panics if both styles were already mixed.
+func RequireSingleNumberingStyle[Enum any]() {
+	core.CheckMutable[Enum](core.MetadataMutation, "require a single numbering style")
+	core.RequireSingleNumberingStyle[Enum]()
+}
+
 // FromInt returns the corresponding enum for a given int representation, and
 // whether it is valid.
 //
@@ -187,35 +359,124 @@ func FromNumber[Enum any, N xreflect.Number](n N) (Enum, bool) {
 //
 // DEPRECATED: Use MustFromNumber instead.
 func MustFromInt[Enum any](i int) Enum {
-	t, _ := FromInt[Enum](i)
+	t, _ := FromNumber[Enum](i)
 	return t
 }
 
 // MustFromNumber returns the corresponding enum for a given number
 // representation.
 //
-// It returns the zero value if the enum value is invalid.
+// It returns the zero value if the enum value is invalid. Use StrictFromNumber
+// to panic instead.
 func MustFromNumber[Enum any, N xreflect.Number](n N) Enum {
 	t, _ := FromNumber[Enum](n)
 	return t
 }
 
 // FromString returns the corresponding enum for a given string representation,
-// and whether it is valid.
+// and whether it is valid. The string is matched as configured by
+// SetStringMatcher.
 func FromString[Enum any](s string) (Enum, bool) {
-	return From[Enum](s)
+	enum, ok := lookupString[Enum](s)
+	if ok {
+		markUsage(enum, usageResolved)
+	}
+
+	return enum, ok
 }
 
 // MustFromString returns the corresponding enum for a given string
 // representation.
 //
 // It returns zero value if the string does not correspond to a valid enum
-// value.
+// value, which may be a valid value too (e.g. the first one of an iota enum).
+// Use StrictFromString to panic instead.
 func MustFromString[Enum any](s string) Enum {
 	enum, _ := FromString[Enum](s)
 	return enum
 }
 
+// StrictFromString is similar to MustFromString, but panics with the message
+// of ParseString (including the valid values) if the string does not
+// correspond to a valid enum value.
+func StrictFromString[Enum any](s string) Enum {
+	return MustOK(ParseString[Enum](s))
+}
+
+// StrictFromNumber is similar to MustFromNumber, but panics with the message
+// of ParseNumber (including the valid values) if the number does not
+// correspond to a valid enum value.
+func StrictFromNumber[Enum any, N xreflect.Number](n N) Enum {
+	return MustOK(ParseNumber[Enum](n))
+}
+
+// StrictFrom is similar to MustFrom, but panics with a message including the
+// valid values if the representation is unknown.
+func StrictFrom[Enum any, P any](a P) Enum {
+	if enum, ok := From[Enum](a); ok {
+		return enum
+	}
+
+	err := &ParseError{Type: TrueNameOf[Enum](), Input: any(a), Allowed: AllStrings[Enum]()}
+	switch v := any(a).(type) {
+	case []byte:
+		err.Input = string(v)
+	default:
+		if !xreflect.IsPrimitiveString(a) && !xreflect.IsPrimitiveNumber(a) {
+			panic(fmt.Sprintf("enum %s: unknown representation %v (allowed values: %s)",
+				err.Type, a, strings.Join(err.Allowed, ", ")))
+		}
+	}
+
+	panic(err.Error())
+}
+
+// FromStringOr is similar to FromString, but returns the default value if the
+// string does not correspond to a valid enum value, e.g. if it is empty.
+func FromStringOr[Enum any](s string, def Enum) Enum {
+	if enum, ok := FromString[Enum](s); ok {
+		return enum
+	}
+
+	return def
+}
+
+// FromNumberOr is similar to FromNumber, but returns the default value if the
+// number does not correspond to a valid enum value.
+func FromNumberOr[Enum any, N xreflect.Number](n N, def Enum) Enum {
+	return FromOr(n, def)
+}
+
+// FromOr is similar to From, but returns the default value if the
+// representation is unknown.
+func FromOr[Enum any, P any](a P, def Enum) Enum {
+	if enum, ok := From[Enum](a); ok {
+		return enum
+	}
+
+	return def
+}
+
+// ParseString is similar to FromString, but returns a *ParseError listing the
+// valid values if the string does not correspond to a valid enum value.
+func ParseString[Enum any](s string) (Enum, error) {
+	if enum, ok := FromString[Enum](s); ok {
+		return enum, nil
+	}
+
+	return xreflect.Zero[Enum](), &ParseError{Type: TrueNameOf[Enum](), Input: s, Allowed: AllStrings[Enum]()}
+}
+
+// ParseNumber is similar to FromNumber, but returns a *ParseError listing the
+// valid values if the number does not correspond to a valid enum value.
+func ParseNumber[Enum any, N xreflect.Number](n N) (Enum, error) {
+	if enum, ok := FromNumber[Enum](n); ok {
+		return enum, nil
+	}
+
+	return xreflect.Zero[Enum](), &ParseError{Type: TrueNameOf[Enum](), Input: n, Allowed: AllStrings[Enum]()}
+}
+
 // ToString returns the string representation of the given enum value. It
 // returns <nil> for invalid enums.
 func ToString[Enum any](value Enum) string {
@@ -227,6 +488,26 @@ func ToString[Enum any](value Enum) string {
 	return str
 }
 
+// ToStringOr is similar to ToString, but returns the fallback for invalid
+// enums.
+func ToStringOr[Enum any](value Enum, fallback string) string {
+	if str, ok := To[string](value); ok {
+		return str
+	}
+
+	return fallback
+}
+
+// ToStringOrElse is similar to ToString, but formats invalid enums with the
+// given function, e.g. to show their raw numbers.
+func ToStringOrElse[Enum any](value Enum, format func(Enum) string) string {
+	if str, ok := To[string](value); ok {
+		return str
+	}
+
+	return format(value)
+}
+
 // ToInt returns the int representation for the given enum value. It returns the
 // smallest value of int (math.MinInt32) for invalid enums.
 //
@@ -242,28 +523,42 @@ func ToInt[Enum any](enum Enum) int {
 }
 
 // From returns the corresponding enum for a given representation, and whether
-// it is valid.
+// it is valid. A []byte is looked up as the string representation.
 func From[Enum any, P any](a P) (Enum, bool) {
-	return mtmap.Get2(mtkey.Repr2Enum[Enum](a))
+	var key any = a
+	if b, ok := key.([]byte); ok {
+		key = string(b)
+	}
+
+	enum, ok := registry.Get2(mtkey.Repr2Enum[Enum](key))
+	if ok {
+		markUsage(enum, usageResolved)
+	}
+
+	return enum, ok
 }
 
 // MustFrom returns the corresponding enum for a given representation. It
-// returns the zero value of enum in case the representation is unknown.
+// returns the zero value of enum in case the representation is unknown. Use
+// StrictFrom to panic instead.
 func MustFrom[Enum any, P any](a P) Enum {
-	e, _ := mtmap.Get2(mtkey.Repr2Enum[Enum](a))
+	e, _ := From[Enum](a)
 	return e
 }
 
 // To returns the representation (the type is relied on P type parameter) for
 // the given enum value. The latter returned value is false if the enum is
 // invalid or the enum doesn't have any representation of type P.
+//
+// The []byte representation is the string representation, shared by all
+// callers without allocation, so it must not be modified.
 func To[P, Enum any](enum Enum) (P, bool) {
-	ret, ok := mtmap.Get2(mtkey.Enum2Repr[Enum, P](enum))
-	if !ok {
-		return xreflect.Zero[P](), false
+	ret, ok := lookupRepr[P](enum)
+	if ok {
+		markUsage(enum, usageMarshaled)
 	}
 
-	return ret.(P), true
+	return ret, ok
 }
 
 // MustTo returns the representation (the type is relied on P type parameter)
@@ -274,45 +569,287 @@ func MustTo[P, Enum any](enum Enum) P {
 	return val
 }
 
+// ToNumber returns the numeric representation of type N for the given enum
+// value, and false if the enum is invalid. Integer types are only available for
+// integral numbers, e.g. not for a float enum of 0.5.
+func ToNumber[N xreflect.Number, Enum any](enum Enum) (N, bool) {
+	return To[N](enum)
+}
+
+// MustToNumber is similar to ToNumber, but returns the zero value if the
+// numeric representation is not available.
+func MustToNumber[N xreflect.Number, Enum any](enum Enum) N {
+	n, _ := ToNumber[N](enum)
+	return n
+}
+
+// ToSafe is similar to To, but returns an error if the enum is invalid or the
+// enum doesn't have any representation of type P.
+func ToSafe[P, Enum any](enum Enum) (P, error) {
+	if val, ok := To[P](enum); ok {
+		return val, nil
+	}
+
+	if !IsValid(enum) {
+		return xreflect.Zero[P](), fmt.Errorf("enum %s: %w %#v", TrueNameOf[Enum](), ErrInvalidEnum, enum)
+	}
+
+	return xreflect.Zero[P](), fmt.Errorf("enum %s (%#v): no representation of type %v",
+		TrueNameOf[Enum](), enum, reflect.TypeOf((*P)(nil)).Elem())
+}
+
+// ToStringSafe is similar to ToString, but returns an error for invalid enums
+// instead of <nil>.
+func ToStringSafe[Enum any](enum Enum) (string, error) {
+	return ToSafe[string](enum)
+}
+
 // IsValid checks if an enum value is valid. It returns true if the enum value
 // is valid, and false otherwise.
 func IsValid[Enum any](value Enum) bool {
-	_, ok := mtmap.Get2(mtkey.Enum2Repr[Enum, string](value))
+	_, ok := registry.Get2(mtkey.Enum2Repr[Enum, string](value))
 	return ok
 }
 
-// MarshalJSON serializes an enum value into its string representation.
+// Contains is an alias of IsValid, which reads better in some call sites, e.g.
+// if enum.Contains(role).
+func Contains[Enum any](value Enum) bool {
+	return IsValid(value)
+}
+
+// IsValidString checks if FromString resolves the string to an enum value,
+// without marking the value as used (see TrackUsage).
+func IsValidString[Enum any](s string) bool {
+	_, ok := lookupString[Enum](s)
+	return ok
+}
+
+// IsValidNumber checks if FromNumber resolves the number to an enum value,
+// without marking the value as used (see TrackUsage).
+func IsValidNumber[Enum any, N xreflect.Number](n N) bool {
+	_, ok := registry.Get2(mtkey.Repr2Enum[Enum](n))
+	return ok
+}
+
+// MarshalJSON serializes an enum value into its string representation, or its
+// numeric representation if SetJSONFormat is JSONNumber for the enum type.
+//
+// If SetMarshalInvalidAsNull is enabled for the enum type, an invalid value is
+// serialized as null (or the configured placeholder) instead of failing.
 func MarshalJSON[Enum any](value Enum) ([]byte, error) {
-	s, ok := mtmap.Get2(mtkey.Enum2JSON(value))
+	data, err := marshalJSON(value)
+	if err != nil {
+		if config := registry.Get(mtkey.InvalidMarshaling[Enum]()); config.Enabled {
+			if config.HasPlaceholder {
+				return []byte(strconv.Quote(config.Placeholder)), nil
+			}
+
+			return []byte("null"), nil
+		}
+	}
+
+	return data, err
+}
+
+func marshalJSON[Enum any](value Enum) ([]byte, error) {
+	if registry.Get(mtkey.JSONNumberFormat[Enum]()) {
+		return marshalJSONNumber(value)
+	}
+
+	return marshalJSONString(value)
+}
+
+func marshalJSONNumber[Enum any](value Enum) ([]byte, error) {
+	if i, ok := To[int64](value); ok {
+		return strconv.AppendInt(nil, i, 10), nil
+	}
+
+	if f, ok := To[float64](value); ok {
+		return strconv.AppendFloat(nil, f, 'g', -1, 64), nil
+	}
+
+	return nil, fmt.Errorf("enum %s: %w %#v", TrueNameOf[Enum](), ErrInvalidEnum, value)
+}
+
+func marshalJSONString[Enum any](value Enum) ([]byte, error) {
+	if isPairFormat[Enum]() {
+		s, err := pairString(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(strconv.Quote(s)), nil
+	}
+
+	s, ok := registry.Get2(mtkey.Enum2JSON(value))
 	if !ok {
-		return nil, fmt.Errorf("enum %s: invalid value %#v", TrueNameOf[Enum](), value)
+		return nil, fmt.Errorf("enum %s: %w %#v", TrueNameOf[Enum](), ErrInvalidEnum, value)
 	}
 
+	markUsage(value, usageMarshaled)
 	return []byte(s), nil
 }
 
 // UnmarshalJSON deserializes a string representation of an enum value from
 // JSON.
+//
+// If AcceptLegacyNumbers is enabled for the enum type, a bare JSON number is
+// also accepted and resolved via its numeric representation.
+//
+// If SetJSONFormat is JSONNumber for the enum type, numbers are accepted as
+// well as strings.
+//
+// The JSON null sets the enum to its zero value, unless SetJSONNullIsError is
+// enabled for the enum type.
 func UnmarshalJSON[Enum any](data []byte, t *Enum) (err error) {
+	if string(data) == "null" {
+		if registry.Get(mtkey.JSONNullIsError[Enum]()) {
+			return fmt.Errorf("enum %s: invalid string null", TrueNameOf[Enum]())
+		}
+
+		var zero Enum
+		*t = zero
+		return nil
+	}
+
 	n := len(data)
 	if n < 2 || data[0] != '"' || data[n-1] != '"' {
+		if (registry.Get(mtkey.JSONNumberFormat[Enum]()) || registry.Get(mtkey.AcceptLegacyNumbers[Enum]())) &&
+			isJSONNumber(data) {
+			return unmarshalJSONNumber(data, t)
+		}
+
 		return fmt.Errorf("enum %s: invalid string %s", TrueNameOf[Enum](), string(data))
 	}
 
-	enum, ok := mtmap.Get2(mtkey.Repr2Enum[Enum](string(data[1 : n-1])))
-	if !ok {
-		return fmt.Errorf("enum %s: unknown string %s", TrueNameOf[Enum](), string(data[1:n-1]))
+	// Strings with escape sequences are unescaped by the standard decoder.
+	if slices.Contains(data[1:n-1], '\\') {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("enum %s: invalid string %s", TrueNameOf[Enum](), string(data))
+		}
+
+		return fromString(s, t)
 	}
 
-	*t = enum
-	return nil
+	return fromString(string(data[1:n-1]), t)
+}
+
+// AcceptLegacyNumbers configures whether UnmarshalJSON and UnmarshalCBOR also
+// accept numbers for the enum type (integers only in CBOR), e.g. documents
+// serialized before the type was given its JSON or CBOR methods, or sent by
+// services which use the numeric representations. MarshalJSON always emits the string representation,
+// so documents are rewritten in the new format once they are saved again.
+//
+// This is a migration aid: disable it (or remove the call) once all stored
+// documents were rewritten.
+func AcceptLegacyNumbers[Enum any](enabled bool) {
+	core.CheckMutable[Enum](core.MetadataMutation, "configure legacy numbers")
+	registry.Set(mtkey.AcceptLegacyNumbers[Enum](), enabled)
+}
+
+// JSONFormat is the JSON representation of an enum type (see SetJSONFormat).
+type JSONFormat int
+
+const (
+	// JSONString serializes values as their string representations, the
+	// default.
+	JSONString JSONFormat = iota
+
+	// JSONNumber serializes values as their numeric representations.
+	JSONNumber
+)
+
+// SetJSONFormat configures the JSON representation of the enum type, e.g. to
+// keep emitting numeric codes for a service which predates the string
+// representations. With JSONNumber, UnmarshalJSON accepts both numbers and
+// strings.
+func SetJSONFormat[Enum any](format JSONFormat) {
+	core.CheckMutable[Enum](core.MetadataMutation, "configure the JSON format")
+
+	if format != JSONString && format != JSONNumber {
+		panic(fmt.Sprintf("enum %s: unknown JSON format %d", TrueNameOf[Enum](), format))
+	}
+
+	registry.Set(mtkey.JSONNumberFormat[Enum](), format == JSONNumber)
+}
+
+// SQLFormat is the SQL representation of an enum type (see SetSQLFormat).
+type SQLFormat int
+
+const (
+	// SQLString stores values as their string representations, the default.
+	SQLString SQLFormat = iota
+
+	// SQLNumber stores values as their numeric representations, e.g. in
+	// INTEGER columns.
+	SQLNumber
+)
+
+// SetSQLFormat configures the SQL representation of the enum type. With
+// SQLNumber, ValueSQL returns an int64 (a float64 for non-integer values) and
+// ScanSQL accepts both numbers and strings.
+func SetSQLFormat[Enum any](format SQLFormat) {
+	core.CheckMutable[Enum](core.MetadataMutation, "configure the SQL format")
+
+	if format != SQLString && format != SQLNumber {
+		panic(fmt.Sprintf("enum %s: unknown SQL format %d", TrueNameOf[Enum](), format))
+	}
+
+	registry.Set(mtkey.SQLNumberFormat[Enum](), format == SQLNumber)
+}
+
+// SetJSONNullIsError configures whether UnmarshalJSON rejects the JSON null
+// for the enum type. By default, null sets the enum to its zero value. Use
+// Nullable to tell null apart from a valid value.
+func SetJSONNullIsError[Enum any](enabled bool) {
+	core.CheckMutable[Enum](core.MetadataMutation, "configure the JSON null")
+	registry.Set(mtkey.JSONNullIsError[Enum](), enabled)
+}
+
+// InvalidMarshalingOption customizes SetMarshalInvalidAsNull.
+type InvalidMarshalingOption func(*mtkey.InvalidMarshalingConfig)
+
+// WithPlaceholder serializes invalid values as the placeholder string instead
+// of null.
+func WithPlaceholder(placeholder string) InvalidMarshalingOption {
+	return func(c *mtkey.InvalidMarshalingConfig) {
+		c.Placeholder = placeholder
+		c.HasPlaceholder = true
+	}
+}
+
+// SetMarshalInvalidAsNull configures whether MarshalJSON and MarshalYAML (and
+// the methods of the enum wrappers) serialize an invalid value of the enum
+// type, e.g. the zero value of a partially populated struct, as null instead
+// of returning an error. By default, they return an error.
+func SetMarshalInvalidAsNull[Enum any](enabled bool, opts ...InvalidMarshalingOption) {
+	core.CheckMutable[Enum](core.MetadataMutation, "configure the invalid marshaling")
+
+	config := mtkey.InvalidMarshalingConfig{Enabled: enabled}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	registry.Set(mtkey.InvalidMarshaling[Enum](), config)
 }
 
 // MarshalYAML serializes an enum value into its string representation.
+//
+// If SetMarshalInvalidAsNull is enabled for the enum type, an invalid value is
+// serialized as null (or the configured placeholder) instead of failing.
 func MarshalYAML[Enum any](value Enum) (any, error) {
-	s, ok := mtmap.Get2(mtkey.Enum2Repr[Enum, string](value))
+	s, ok := To[string](value)
 	if !ok {
-		return nil, fmt.Errorf("enum %s: invalid value %#v", TrueNameOf[Enum](), value)
+		if config := registry.Get(mtkey.InvalidMarshaling[Enum]()); config.Enabled {
+			if config.HasPlaceholder {
+				return config.Placeholder, nil
+			}
+
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("enum %s: %w %#v", TrueNameOf[Enum](), ErrInvalidEnum, value)
 	}
 
 	return s, nil
@@ -320,12 +857,21 @@ func MarshalYAML[Enum any](value Enum) (any, error) {
 
 // UnmarshalYAML deserializes a string representation of an enum value from
 // YAML.
+//
+// Plain integer and float scalars (e.g. role: 1) are resolved via the numeric
+// representation, then via the string representation as written, for enums
+// whose strings look like numbers.
 func UnmarshalYAML[Enum any](value *yaml.Node, t *Enum) error {
 	// Check if the value is a scalar (string in this case)
 	if value.Kind != yaml.ScalarNode {
 		return fmt.Errorf("enum %s: only supports scalar in yaml enum", TrueNameOf[Enum]())
 	}
 
+	switch value.ShortTag() {
+	case "!!int", "!!float":
+		return unmarshalYAMLNumber(value, t)
+	}
+
 	// Assign the string value directly
 	var s string
 	if err := value.Decode(&s); err != nil {
@@ -333,19 +879,268 @@ func UnmarshalYAML[Enum any](value *yaml.Node, t *Enum) error {
 	}
 
 	var ok bool
-	*t, ok = From[Enum](s)
+	*t, ok = FromString[Enum](s)
+	if !ok {
+		return unknownStringError[Enum](s)
+	}
+
+	return nil
+}
+
+func unmarshalYAMLNumber[Enum any](value *yaml.Node, t *Enum) error {
+	var n any
+	if value.ShortTag() == "!!int" {
+		var i int64
+		if err := value.Decode(&i); err != nil {
+			return err
+		}
+		n = i
+	} else {
+		var f float64
+		if err := value.Decode(&f); err != nil {
+			return err
+		}
+		n = f
+	}
+
+	var ok bool
+	if *t, ok = From[Enum](n); ok {
+		return nil
+	}
+
+	if *t, ok = FromString[Enum](value.Value); ok {
+		return nil
+	}
+
+	return unknownNumberError[Enum](n)
+}
+
+// MarshalTOML serializes an enum value into a TOML string. It implements the
+// Marshaler interface of BurntSushi/toml and pelletier/go-toml (v1), while
+// pelletier/go-toml/v2 relies on MarshalText.
+//
+// TOML has no null, so SetMarshalInvalidAsNull doesn't apply, and values are
+// always strings, regardless of SetJSONFormat.
+func MarshalTOML[Enum any](value Enum) ([]byte, error) {
+	return marshalJSONString(value)
+}
+
+// UnmarshalTOML deserializes a decoded TOML value into an enum value. It
+// implements the Unmarshaler interface of BurntSushi/toml and
+// pelletier/go-toml (v1), while pelletier/go-toml/v2 relies on UnmarshalText.
+func UnmarshalTOML[Enum any](value any, t *Enum) error {
+	s, ok := value.(string)
 	if !ok {
-		return fmt.Errorf("enum %s: unknown string %s", TrueNameOf[Enum](), s)
+		return fmt.Errorf("enum %s: only supports string in toml enum", TrueNameOf[Enum]())
+	}
+
+	*t, ok = FromString[Enum](s)
+	if !ok {
+		return unknownStringError[Enum](s)
 	}
 
 	return nil
 }
 
+// MarshalGQL writes the quoted string representation of an enum value. It
+// implements the graphql.Marshaler interface of gqlgen for the enum wrappers.
+// As the interface can't return an error, invalid values are written as null.
+func MarshalGQL[Enum any](w io.Writer, value Enum) {
+	data, err := marshalJSONString(value)
+	if err != nil {
+		data = []byte("null")
+	}
+
+	_, _ = w.Write(data)
+}
+
+// UnmarshalGQL deserializes a GraphQL input value, a string or a json.Number,
+// into an enum value. It implements the graphql.Unmarshaler interface of
+// gqlgen for the enum wrappers.
+func UnmarshalGQL[Enum any](value any, t *Enum) error {
+	switch v := value.(type) {
+	case string:
+		enum, ok := FromString[Enum](v)
+		if !ok {
+			return unknownStringError[Enum](v)
+		}
+
+		*t = enum
+		return nil
+	case json.Number:
+		var enum Enum
+		var ok bool
+		if i, err := v.Int64(); err == nil {
+			enum, ok = FromNumber[Enum](i)
+		} else if f, err := v.Float64(); err == nil {
+			enum, ok = FromNumber[Enum](f)
+		}
+
+		if !ok {
+			return unknownNumberError[Enum](v)
+		}
+
+		*t = enum
+		return nil
+	default:
+		return fmt.Errorf("enum %s: %w %v", TrueNameOf[Enum](), ErrUnsupportedScanType, reflect.TypeOf(value))
+	}
+}
+
+// MarshalText serializes an enum value into its string representation. It
+// implements encoding.TextMarshaler for the enum wrappers, which allows them to
+// be used, for example, as keys of JSON maps.
+func MarshalText[Enum any](value Enum) ([]byte, error) {
+	str, ok := To[string](value)
+	if !ok {
+		return nil, fmt.Errorf("enum %s: %w %#v", TrueNameOf[Enum](), ErrInvalidEnum, value)
+	}
+
+	return []byte(str), nil
+}
+
+// UnmarshalText deserializes a string representation of an enum value.
+func UnmarshalText[Enum any](data []byte, t *Enum) error {
+	enum, ok := FromString[Enum](string(data))
+	if !ok {
+		return unknownStringError[Enum](string(data))
+	}
+
+	*t = enum
+	return nil
+}
+
+// MarshalBinary serializes an enum value into its string representation, so
+// stored values don't depend on the numeric values. It implements
+// encoding.BinaryMarshaler for the enum wrappers, e.g. for Redis clients.
+func MarshalBinary[Enum any](value Enum) ([]byte, error) {
+	return MarshalText(value)
+}
+
+// UnmarshalBinary deserializes a string representation of an enum value
+// encoded by MarshalBinary.
+func UnmarshalBinary[Enum any](data []byte, t *Enum) error {
+	return UnmarshalText(data, t)
+}
+
+// GobEncode serializes an enum value into its string representation for
+// encoding/gob, so gob data doesn't depend on the numeric values.
+func GobEncode[Enum any](value Enum) ([]byte, error) {
+	return MarshalText(value)
+}
+
+// GobDecode deserializes a string representation of an enum value encoded by
+// GobEncode.
+func GobDecode[Enum any](data []byte, t *Enum) error {
+	return UnmarshalText(data, t)
+}
+
+// MarshalBSONValue serializes an enum value into a BSON string value. Its
+// signature matches the bson.ValueMarshaler interface of the MongoDB driver
+// (v2), whose type byte is the BSON element type.
+func MarshalBSONValue[Enum any](value Enum) (byte, []byte, error) {
+	str, ok := To[string](value)
+	if !ok {
+		return 0, nil, fmt.Errorf("enum %s: %w %#v", TrueNameOf[Enum](), ErrInvalidEnum, value)
+	}
+
+	return xbson.TypeString, xbson.AppendString(nil, str), nil
+}
+
+// UnmarshalBSONValue deserializes a BSON string value, or a BSON int32 or int64
+// value for documents stored before the string representation was used. Its
+// signature matches the bson.ValueUnmarshaler interface of the MongoDB driver
+// (v2).
+func UnmarshalBSONValue[Enum any](typ byte, data []byte, t *Enum) error {
+	var enum Enum
+	var ok bool
+
+	switch typ {
+	case xbson.TypeString:
+		str, err := xbson.ReadString(data)
+		if err != nil {
+			return fmt.Errorf("enum %s: %w", TrueNameOf[Enum](), err)
+		}
+
+		if enum, ok = FromString[Enum](str); !ok {
+			return unknownStringError[Enum](str)
+		}
+
+	case xbson.TypeInt32, xbson.TypeInt64:
+		n, err := xbson.ReadInt(typ, data)
+		if err != nil {
+			return fmt.Errorf("enum %s: %w", TrueNameOf[Enum](), err)
+		}
+
+		if enum, ok = From[Enum](n); !ok {
+			return unknownNumberError[Enum](n)
+		}
+
+	default:
+		return fmt.Errorf("enum %s: not support bson type 0x%02x", TrueNameOf[Enum](), typ)
+	}
+
+	*t = enum
+	return nil
+}
+
+// MarshalCBOR serializes an enum value into a CBOR text string.
+func MarshalCBOR[Enum any](value Enum) ([]byte, error) {
+	str, ok := To[string](value)
+	if !ok {
+		return nil, fmt.Errorf("enum %s: %w %#v", TrueNameOf[Enum](), ErrInvalidEnum, value)
+	}
+
+	return xcbor.AppendText(nil, str), nil
+}
+
+// UnmarshalCBOR deserializes a CBOR text string into an enum value. CBOR
+// integers are also accepted if enabled by AcceptLegacyNumbers. An unknown
+// string results in an *UnknownStringError.
+func UnmarshalCBOR[Enum any](data []byte, t *Enum) error {
+	major, err := xcbor.Major(data)
+	if err != nil {
+		return fmt.Errorf("enum %s: %w", TrueNameOf[Enum](), err)
+	}
+
+	var enum Enum
+	var ok bool
+
+	switch {
+	case major == xcbor.MajorText:
+		str, err := xcbor.ReadText(data)
+		if err != nil {
+			return fmt.Errorf("enum %s: %w", TrueNameOf[Enum](), err)
+		}
+
+		if enum, ok = FromString[Enum](str); !ok {
+			return unknownStringError[Enum](str)
+		}
+
+	case (major == xcbor.MajorUnsigned || major == xcbor.MajorNegative) &&
+		registry.Get(mtkey.AcceptLegacyNumbers[Enum]()):
+		n, err := xcbor.ReadInt(data)
+		if err != nil {
+			return fmt.Errorf("enum %s: %w", TrueNameOf[Enum](), err)
+		}
+
+		if enum, ok = FromNumber[Enum](n); !ok {
+			return unknownNumberError[Enum](n)
+		}
+
+	default:
+		return fmt.Errorf("enum %s: not support cbor major type %d", TrueNameOf[Enum](), major)
+	}
+
+	*t = enum
+	return nil
+}
+
 // MarshalXML converts enum to its string representation.
 func MarshalXML[Enum any](encoder *xml.Encoder, start xml.StartElement, enum Enum) error {
 	str, ok := To[string](enum)
 	if !ok {
-		return fmt.Errorf("enum %s: invalid value %#v", TrueNameOf[Enum](), enum)
+		return fmt.Errorf("enum %s: %w %#v", TrueNameOf[Enum](), ErrInvalidEnum, enum)
 	}
 
 	if start.Name.Local == "" {
@@ -364,7 +1159,7 @@ func UnmarshalXML[Enum any](decoder *xml.Decoder, start xml.StartElement, enum *
 
 	val, ok := FromString[Enum](str)
 	if !ok {
-		return fmt.Errorf("enum %s: unknown string %s", TrueNameOf[Enum](), str)
+		return unknownStringError[Enum](str)
 	}
 
 	*enum = val
@@ -373,15 +1168,35 @@ func UnmarshalXML[Enum any](decoder *xml.Decoder, start xml.StartElement, enum *
 
 // ValueSQL serializes an enum into a database-compatible format.
 func ValueSQL[Enum any](value Enum) (driver.Value, error) {
-	str, ok := mtmap.Get2(mtkey.Enum2Repr[Enum, string](value))
+	if registry.Get(mtkey.SQLNumberFormat[Enum]()) {
+		if i, ok := To[int64](value); ok {
+			return i, nil
+		}
+
+		if f, ok := To[float64](value); ok {
+			return f, nil
+		}
+
+		return nil, fmt.Errorf("enum %s: %w %#v", TrueNameOf[Enum](), ErrInvalidEnum, value)
+	}
+
+	if isPairFormat[Enum]() {
+		return pairString(value)
+	}
+
+	str, ok := To[string](value)
 	if !ok {
-		return nil, fmt.Errorf("enum %s: invalid value %#v", TrueNameOf[Enum](), value)
+		return nil, fmt.Errorf("enum %s: %w %#v", TrueNameOf[Enum](), ErrInvalidEnum, value)
 	}
 
 	return str, nil
 }
 
 // ScanSQL deserializes a database value into an enum type.
+//
+// The value may be a string or []byte (e.g. the label of a PostgreSQL enum
+// column), an int64 or float64 if SetSQLFormat is SQLNumber for the enum type,
+// or a typed wrapper implementing driver.Valuer which resolves to one of them.
 func ScanSQL[Enum any](a any, value *Enum) error {
 	var data string
 	switch t := a.(type) {
@@ -389,22 +1204,301 @@ func ScanSQL[Enum any](a any, value *Enum) error {
 		data = t
 	case []byte:
 		data = string(t)
+	case int64, float64:
+		if !registry.Get(mtkey.SQLNumberFormat[Enum]()) {
+			return fmt.Errorf("enum %s: %w %v", TrueNameOf[Enum](), ErrUnsupportedScanType, reflect.TypeOf(a))
+		}
+
+		enum, ok := From[Enum](t)
+		if !ok {
+			return unknownNumberError[Enum](t)
+		}
+
+		*value = enum
+		return nil
+	case driver.Valuer:
+		v, err := t.Value()
+		if err != nil {
+			return fmt.Errorf("enum %s: %w", TrueNameOf[Enum](), err)
+		}
+
+		if _, ok := v.(driver.Valuer); ok {
+			return fmt.Errorf("enum %s: %w %v", TrueNameOf[Enum](), ErrUnsupportedScanType, reflect.TypeOf(a))
+		}
+
+		return ScanSQL(v, value)
 	default:
-		return fmt.Errorf("enum %s: not support type %s", TrueNameOf[Enum](), reflect.TypeOf(a))
+		return fmt.Errorf("enum %s: %w %v", TrueNameOf[Enum](), ErrUnsupportedScanType, reflect.TypeOf(a))
 	}
 
-	enum, ok := mtmap.Get2(mtkey.Repr2Enum[Enum](data))
+	return fromString(data, value)
+}
+
+// All returns a slice containing all enum values of a specific type. The slice
+// is a copy, so it can be sorted or appended freely.
+func All[Enum any]() []Enum {
+	return slices.Clone(AllRef[Enum]())
+}
+
+// AllRef is similar to All, but returns the registered slice without copying
+// it. The slice is shared by all callers, so it must not be modified.
+func AllRef[Enum any]() []Enum {
+	return registry.Get(mtkey.AllEnums[Enum]())
+}
+
+// Compare compares two enum values by their numeric representations, then by
+// their string representations if the numbers are equal. Values without
+// numeric representation sort after the others, and invalid values sort last.
+// It returns -1 if a sorts before b, 1 if a sorts after b, and 0 otherwise.
+//
+//	slices.SortFunc(roles, enum.Compare)
+func Compare[Enum any](a, b Enum) int {
+	aStr, aValid := lookupRepr[string](a)
+	bStr, bValid := lookupRepr[string](b)
+	if aValid != bValid {
+		return boolOrder(aValid)
+	}
+
+	_, aNum := lookupRepr[float64](a)
+	_, bNum := lookupRepr[float64](b)
+	if aNum != bNum {
+		return boolOrder(aNum)
+	}
+
+	if aNum {
+		if c := compareNumbers(a, b); c != 0 {
+			return c
+		}
+	}
+
+	return strings.Compare(aStr, bStr)
+}
+
+// Less reports whether a sorts before b (see Compare).
+func Less[Enum any](a, b Enum) bool {
+	return Compare(a, b) < 0
+}
+
+// boolOrder returns -1 if the first operand has the property, 1 otherwise.
+func boolOrder(first bool) int {
+	if first {
+		return -1
+	}
+
+	return 1
+}
+
+// AllSorted returns a slice containing all enum values of a specific type, in
+// the order of Compare. The order of All is not affected.
+func AllSorted[Enum any]() []Enum {
+	return slices.Clone(sortedValues[Enum]())
+}
+
+// sortedValues returns the values of AllSorted without copying them. The
+// result is cached once the enum type is finalized, so it must not be
+// modified.
+func sortedValues[Enum any]() []Enum {
+	if sorted, ok := registry.Get2(mtkey.AllSortedEnums[Enum]()); ok {
+		return sorted
+	}
+
+	sorted := All[Enum]()
+	slices.SortStableFunc(sorted, Compare[Enum])
+
+	if registry.Get(mtkey.IsFinalized[Enum]()) {
+		registry.Set(mtkey.AllSortedEnums[Enum](), sorted)
+	}
+
+	return sorted
+}
+
+// Min returns the enum value with the smallest numeric representation, and
+// false if the enum type has no value.
+func Min[Enum any]() (Enum, bool) {
+	return extremeValue[Enum](-1)
+}
+
+// MustMin is similar to Min, but returns the zero value if the enum type has no
+// value.
+func MustMin[Enum any]() Enum {
+	enum, _ := Min[Enum]()
+	return enum
+}
+
+// Max returns the enum value with the largest numeric representation, and
+// false if the enum type has no value.
+func Max[Enum any]() (Enum, bool) {
+	return extremeValue[Enum](1)
+}
+
+// MustMax is similar to Max, but returns the zero value if the enum type has no
+// value.
+func MustMax[Enum any]() Enum {
+	enum, _ := Max[Enum]()
+	return enum
+}
+
+// Next returns the enum value following the given one in the order of
+// AllSorted. It returns false if the given value is the last one or invalid.
+func Next[Enum any](value Enum) (Enum, bool) {
+	return stepValue(value, 1, false)
+}
+
+// Prev returns the enum value preceding the given one in the order of
+// AllSorted. It returns false if the given value is the first one or invalid.
+func Prev[Enum any](value Enum) (Enum, bool) {
+	return stepValue(value, -1, false)
+}
+
+// NextWrap is similar to Next, but returns the first value after the last one.
+func NextWrap[Enum any](value Enum) (Enum, bool) {
+	return stepValue(value, 1, true)
+}
+
+// PrevWrap is similar to Prev, but returns the last value before the first one.
+func PrevWrap[Enum any](value Enum) (Enum, bool) {
+	return stepValue(value, -1, true)
+}
+
+// Clamp returns the enum value whose numeric representation is the nearest to
+// the given number, or the lower one if two values are equally near. Numbers
+// out of the range of the enum type map to Min or Max.
+//
+// It returns the zero value if the enum type has no value.
+func Clamp[Enum any, N xreflect.Number](n N) Enum {
+	return ClampOr(n, xreflect.Zero[Enum]())
+}
+
+// ClampOr is similar to Clamp, but returns the fallback value if the enum type
+// has no value.
+func ClampOr[Enum any, N xreflect.Number](n N, fallback Enum) Enum {
+	if enum, ok := FromNumber[Enum](n); ok {
+		return enum
+	}
+
+	target := float64(n)
+	nearest, bestDistance := fallback, math.Inf(1)
+	for _, value := range sortedValues[Enum]() {
+		number, ok := lookupRepr[float64](value)
+		if !ok {
+			continue
+		}
+
+		// Values are sorted, so the lower value wins ties.
+		if distance := math.Abs(number - target); distance < bestDistance {
+			nearest, bestDistance = value, distance
+		}
+	}
+
+	return nearest
+}
+
+// stepValue returns the value at the given offset from the value in the order
+// of AllSorted.
+func stepValue[Enum any](value Enum, offset int, wrap bool) (Enum, bool) {
+	str, ok := lookupRepr[string](value)
 	if !ok {
-		return fmt.Errorf("enum %s: unknown string %s", TrueNameOf[Enum](), data)
+		return xreflect.Zero[Enum](), false
 	}
 
-	*value = enum
-	return nil
+	sorted := sortedValues[Enum]()
+	for i, v := range sorted {
+		if reprOf[string](v) != str {
+			continue
+		}
+
+		i += offset
+		if wrap {
+			i = (i + len(sorted)) % len(sorted)
+		}
+
+		if i < 0 || i >= len(sorted) {
+			return xreflect.Zero[Enum](), false
+		}
+
+		return sorted[i], true
+	}
+
+	return xreflect.Zero[Enum](), false
 }
 
-// All returns a slice containing all enum values of a specific type.
-func All[Enum any]() []Enum {
-	return mtmap.Get(mtkey.AllEnums[Enum]())
+// extremeValue returns the first value with the smallest (sign -1) or the
+// largest (sign 1) numeric representation.
+func extremeValue[Enum any](sign int) (Enum, bool) {
+	var extreme Enum
+	found := false
+	for _, value := range AllRef[Enum]() {
+		if _, ok := lookupRepr[float64](value); !ok {
+			continue
+		}
+
+		if !found || compareNumbers(value, extreme) == sign {
+			extreme, found = value, true
+		}
+	}
+
+	return extreme, found
+}
+
+// compareNumbers compares the numeric representations of two enum values. The
+// integer representations break ties, which keeps the comparison exact for
+// integers too large for float64.
+func compareNumbers[Enum any](a, b Enum) int {
+	if c := cmp.Compare(reprOf[float64](a), reprOf[float64](b)); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(reprOf[int64](a), reprOf[int64](b))
+}
+
+// AllStrings returns the string representations of all enum values of a
+// specific type, in the same order as All. Aliases are not included.
+func AllStrings[Enum any]() []string {
+	return slices.Clone(registry.Get(mtkey.AllStrings[Enum]()))
+}
+
+// NamedValue is an enum value together with its string representation.
+type NamedValue[Enum any] struct {
+	Value Enum
+	Name  string
+}
+
+// Pairs returns all enum values of a specific type together with their string
+// representations, in the same order as All.
+func Pairs[Enum any]() []NamedValue[Enum] {
+	values := AllRef[Enum]()
+	pairs := make([]NamedValue[Enum], len(values))
+	for i, value := range values {
+		pairs[i] = NamedValue[Enum]{Value: value, Name: reprOf[string](value)}
+	}
+
+	return pairs
+}
+
+// Count returns the number of enum values of a specific type, or 0 if the enum
+// type has no registered value. Aliases are not counted.
+func Count[Enum any]() int {
+	return len(registry.Get(mtkey.AllEnums[Enum]()))
+}
+
+// InternStats returns the number of unique strings interned across all enum
+// types and the number of times strings were interned while registering
+// values. The string representations and their quoted JSON forms are
+// interned, so identical strings of different enum types share their backing
+// storage.
+func InternStats() (uniqueStrings, totalReferences int) {
+	return core.InternStats()
+}
+
+// RegisteredFromPackages returns the paths of all packages which registered
+// values of the enum type, in order of their first registration.
+//
+// Advanced enums with the same underlying type (e.g. two aliases of
+// WrapEnum[role] declared in different packages) are the identical Go type, so
+// they share their values. A test can use this function to assert that an enum
+// type is owned by a single package.
+func RegisteredFromPackages[Enum any]() []string {
+	return slices.Clone(registry.Get(mtkey.RegisteredFrom[Enum]()))
 }
 
 // NameOf returns the name of the enum type. In case of this is an advanced enum
@@ -440,7 +1534,7 @@ func mustHaveUnderlyingRepr[underlyingEnum, Enum any](e Enum) {
 
 	mapUnderlying[underlyingEnum](e)
 
-	if _, ok := To[underlyingEnum](e); !ok {
+	if _, ok := lookupRepr[underlyingEnum](e); !ok {
 		panic(fmt.Sprintf("enum %s (%#v): require a representation of %T",
 			TrueNameOf[Enum](), e, xreflect.Zero[underlyingEnum]()))
 	}
@@ -457,17 +1551,17 @@ func mapUnderlying[underlyingEnum, Enum any](enum Enum) {
 	var repr underlyingEnum
 	switch {
 	case xreflect.IsSignedInt(repr):
-		repr = xreflect.Convert[underlyingEnum](MustTo[int64](enum))
+		repr = xreflect.Convert[underlyingEnum](reprOf[int64](enum))
 	case xreflect.IsUnsignedInt(repr):
-		repr = xreflect.Convert[underlyingEnum](MustTo[uint64](enum))
+		repr = xreflect.Convert[underlyingEnum](reprOf[uint64](enum))
 	case xreflect.IsFloat32(repr):
-		repr = xreflect.Convert[underlyingEnum](MustTo[float32](enum))
+		repr = xreflect.Convert[underlyingEnum](reprOf[float32](enum))
 	case xreflect.IsFloat64(repr):
-		repr = xreflect.Convert[underlyingEnum](MustTo[float64](enum))
+		repr = xreflect.Convert[underlyingEnum](reprOf[float64](enum))
 	case xreflect.IsString(repr):
-		repr = xreflect.Convert[underlyingEnum](MustTo[string](enum))
+		repr = xreflect.Convert[underlyingEnum](reprOf[string](enum))
 	default:
-		str := MustTo[string](enum)
+		str := reprOf[string](enum)
 		if reflect.TypeOf(str).ConvertibleTo(reflect.TypeOf((*underlyingEnum)(nil)).Elem()) {
 			repr = xreflect.Convert[underlyingEnum](str)
 		} else {
@@ -476,6 +1570,74 @@ func mapUnderlying[underlyingEnum, Enum any](enum Enum) {
 		}
 	}
 
-	mtmap.Set(mtkey.Repr2Enum[Enum](repr), enum)
-	mtmap.Set(mtkey.Enum2Repr[Enum, underlyingEnum](enum), any(repr))
+	registry.Set(mtkey.Repr2Enum[Enum](repr), enum)
+	registry.Set(mtkey.Enum2Repr[Enum, underlyingEnum](enum), any(repr))
+}
+
+// lookupRepr returns the representation P of the given enum without recording
+// its usage. It is used internally where a lookup must not count as a
+// marshal (e.g. during registration).
+func lookupRepr[P, Enum any](enum Enum) (P, bool) {
+	ret, ok := registry.Get2(mtkey.Enum2Repr[Enum, P](enum))
+	if !ok {
+		return xreflect.Zero[P](), false
+	}
+
+	return ret.(P), true
+}
+
+// reprOf is similar to lookupRepr, but returns the zero value for unknown
+// representations.
+func reprOf[P, Enum any](enum Enum) P {
+	ret, _ := lookupRepr[P](enum)
+	return ret
+}
+
+// fromString resolves the string representation of an enum value, or the
+// dotted form of its pair if enabled by UsePairFormat.
+func fromString[Enum any](s string, t *Enum) error {
+	enum, ok := FromString[Enum](s)
+	if !ok && isPairFormat[Enum]() {
+		var err error
+		if enum, ok, err = fromPairString[Enum](s); err != nil {
+			return err
+		}
+	}
+
+	if !ok {
+		return unknownStringError[Enum](s)
+	}
+
+	*t = enum
+	return nil
+}
+
+// isJSONNumber returns true if the data starts like a JSON number. The number
+// itself is validated when it is parsed.
+func isJSONNumber(data []byte) bool {
+	return len(data) > 0 && (data[0] == '-' || (data[0] >= '0' && data[0] <= '9'))
+}
+
+// unmarshalJSONNumber resolves a JSON number via the numeric representations
+// of the enum type.
+//
+// Integers are resolved via int64, other numbers (e.g. 0.5 or 1e3) via
+// float64.
+func unmarshalJSONNumber[Enum any](data []byte, t *Enum) error {
+	var n any
+	if i, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		n = i
+	} else if f, err := strconv.ParseFloat(string(data), 64); err == nil {
+		n = f
+	} else {
+		return fmt.Errorf("enum %s: invalid number %s", TrueNameOf[Enum](), string(data))
+	}
+
+	enum, ok := From[Enum](n)
+	if !ok {
+		return unknownNumberError[Enum](json.Number(data))
+	}
+
+	*t = enum
+	return nil
 }
@@ -0,0 +1,128 @@
+package enumtest
+
+import (
+	"testing"
+
+	"github.com/xybor-x/enum"
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+	"gopkg.in/yaml.v3"
+)
+
+// RunContract runs the round-trip tests every enum type is expected to pass,
+// in a sub-test per registered value named after its string representation:
+//
+//   - FromString resolves ToString back to the value;
+//   - UnmarshalJSON decodes MarshalJSON back to the value;
+//   - ScanSQL scans ValueSQL back to the value;
+//   - UnmarshalYAML decodes MarshalYAML back to the value.
+//
+// If the zero value of the enum type is not registered, it also checks that
+// MarshalJSON and ValueSQL reject it (or that MarshalJSON emits null if
+// enabled by SetMarshalInvalidAsNull).
+//
+// The tests follow the serialization settings of the enum type, e.g.
+// SetJSONFormat or SetSQLFormat, so RunContract should be called after the
+// enum type is configured.
+func RunContract[Enum comparable](t *testing.T) {
+	t.Helper()
+
+	values := enum.All[Enum]()
+	if len(values) == 0 {
+		t.Fatalf("enum %s: no registered value", enum.TrueNameOf[Enum]())
+	}
+
+	for _, value := range values {
+		value := value
+		t.Run(enum.ToString(value), func(t *testing.T) {
+			checkStringRoundTrip(t, value)
+			checkJSONRoundTrip(t, value)
+			checkSQLRoundTrip(t, value)
+			checkYAMLRoundTrip(t, value)
+		})
+	}
+
+	var zero Enum
+	if !enum.IsValid(zero) {
+		t.Run("invalid zero value", func(t *testing.T) {
+			checkInvalidRejected(t, zero)
+		})
+	}
+}
+
+func checkStringRoundTrip[Enum comparable](t *testing.T, value Enum) {
+	t.Helper()
+
+	got, ok := enum.FromString[Enum](enum.ToString(value))
+	if !ok || got != value {
+		t.Errorf("FromString(%q) = %#v, %v, want %#v", enum.ToString(value), got, ok, value)
+	}
+}
+
+func checkJSONRoundTrip[Enum comparable](t *testing.T, value Enum) {
+	t.Helper()
+
+	data, err := enum.MarshalJSON(value)
+	if err != nil {
+		t.Errorf("MarshalJSON: %v", err)
+		return
+	}
+
+	var got Enum
+	if err := enum.UnmarshalJSON(data, &got); err != nil || got != value {
+		t.Errorf("UnmarshalJSON(%s) = %#v, %v, want %#v", data, got, err, value)
+	}
+}
+
+func checkSQLRoundTrip[Enum comparable](t *testing.T, value Enum) {
+	t.Helper()
+
+	v, err := enum.ValueSQL(value)
+	if err != nil {
+		t.Errorf("ValueSQL: %v", err)
+		return
+	}
+
+	var got Enum
+	if err := enum.ScanSQL(v, &got); err != nil || got != value {
+		t.Errorf("ScanSQL(%#v) = %#v, %v, want %#v", v, got, err, value)
+	}
+}
+
+func checkYAMLRoundTrip[Enum comparable](t *testing.T, value Enum) {
+	t.Helper()
+
+	v, err := enum.MarshalYAML(value)
+	if err != nil {
+		t.Errorf("MarshalYAML: %v", err)
+		return
+	}
+
+	var node yaml.Node
+	if err := node.Encode(v); err != nil {
+		t.Errorf("encode %#v as YAML: %v", v, err)
+		return
+	}
+
+	var got Enum
+	if err := enum.UnmarshalYAML(&node, &got); err != nil || got != value {
+		t.Errorf("UnmarshalYAML(%#v) = %#v, %v, want %#v", v, got, err, value)
+	}
+}
+
+func checkInvalidRejected[Enum comparable](t *testing.T, value Enum) {
+	t.Helper()
+
+	data, err := enum.MarshalJSON(value)
+	if registry.Get(mtkey.InvalidMarshaling[Enum]()).Enabled {
+		if err != nil {
+			t.Errorf("MarshalJSON(%#v): %v, want a placeholder or null", value, err)
+		}
+	} else if err == nil {
+		t.Errorf("MarshalJSON(%#v) = %s, want an error", value, data)
+	}
+
+	if v, err := enum.ValueSQL(value); err == nil {
+		t.Errorf("ValueSQL(%#v) = %#v, want an error", value, v)
+	}
+}
@@ -0,0 +1,188 @@
+// Package enumtest provides helpers to write tests involving enums.
+package enumtest
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"strings"
+
+	"github.com/xybor-x/enum/internal/core"
+)
+
+const enumPkgPath = "github.com/xybor-x/enum"
+
+// advancedEnumNames are the generic enum types of the enum package, which are
+// enums even before any value is registered.
+var advancedEnumNames = []string{"WrapEnum[", "WrapUintEnum[", "WrapFloatEnum[", "SafeEnum["}
+
+// Option customizes Fill.
+type Option func(*config)
+
+type config struct {
+	seed    int64
+	seeded  bool
+	visited map[uintptr]bool
+	errs    []error
+}
+
+// WithSeed picks a value for each field derived from the seed and the path of
+// the field, instead of the first registered value. The same seed always
+// fills the same values.
+func WithSeed(seed int64) Option {
+	return func(c *config) {
+		c.seed = seed
+		c.seeded = true
+	}
+}
+
+// Fill sets every enum field of the struct pointed to by v to a valid value,
+// by default the first registered value of its type. It follows pointers,
+// slices, arrays, map values, and embedded or nested structs. A nullable enum
+// is set to a valid value, and a nil pointer to an enum is allocated. Empty
+// slices and maps, nil pointers to other types and non-enum fields are left
+// untouched.
+//
+// It returns an error if v is not a non-nil pointer to a struct, or if a field
+// is a generic enum of the enum package (e.g. WrapEnum[role]) without any
+// registered value.
+func Fill(v any, opts ...Option) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("enumtest: Fill requires a non-nil pointer to a struct, got %T", v)
+	}
+
+	c := &config{visited: map[uintptr]bool{}}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.fill("", value)
+	return errors.Join(c.errs...)
+}
+
+func (c *config) fill(path string, value reflect.Value) {
+	typ := value.Type()
+
+	if info, ok := core.LookupType(typ); ok {
+		c.set(path, value, info)
+		return
+	}
+
+	if isAdvancedEnum(typ) {
+		c.errs = append(c.errs, fmt.Errorf("enumtest: %s: enum %s has no registered values", path, typ))
+		return
+	}
+
+	switch typ.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			if !isEnum(typ.Elem()) || !value.CanSet() {
+				return
+			}
+
+			value.Set(reflect.New(typ.Elem()))
+		}
+
+		if c.visited[value.Pointer()] {
+			return
+		}
+
+		c.visited[value.Pointer()] = true
+		c.fill(path, value.Elem())
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			c.fill(fmt.Sprintf("%s[%d]", path, i), value.Index(i))
+		}
+
+	case reflect.Map:
+		// Map values are not addressable, fill a copy then store it back.
+		iter := value.MapRange()
+		for iter.Next() {
+			elem := reflect.New(typ.Elem()).Elem()
+			elem.Set(iter.Value())
+
+			c.fill(fmt.Sprintf("%s[%v]", path, iter.Key()), elem)
+			value.SetMapIndex(iter.Key(), elem)
+		}
+
+	case reflect.Struct:
+		if isNullable(typ) {
+			c.fill(path, value.FieldByName("Enum"))
+			value.FieldByName("Valid").SetBool(true)
+			return
+		}
+
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			// Flatten embedded structs, like the paths of PreflightMarshal.
+			fieldPath := joinPath(path, field.Name)
+			if field.Anonymous && !isEnum(field.Type) {
+				fieldPath = path
+			}
+
+			c.fill(fieldPath, value.Field(i))
+		}
+	}
+}
+
+// set sets the enum value to a registered value of its type.
+func (c *config) set(path string, value reflect.Value, info *core.TypeInfo) {
+	values := info.Values()
+	if len(values) == 0 {
+		c.errs = append(c.errs, fmt.Errorf("enumtest: %s: enum %s has no registered values", path, info.Name))
+		return
+	}
+
+	index := 0
+	if c.seeded {
+		h := fnv.New64a()
+		fmt.Fprintf(h, "%d/%s", c.seed, path)
+		index = int(h.Sum64() % uint64(len(values)))
+	}
+
+	if value.CanSet() {
+		value.Set(reflect.ValueOf(values[index]))
+	}
+}
+
+// isEnum returns true if values of the type are filled as enums.
+func isEnum(typ reflect.Type) bool {
+	if _, ok := core.LookupType(typ); ok {
+		return true
+	}
+
+	return isAdvancedEnum(typ) || isNullable(typ)
+}
+
+func isAdvancedEnum(typ reflect.Type) bool {
+	if typ.PkgPath() != enumPkgPath {
+		return false
+	}
+
+	for _, name := range advancedEnumNames {
+		if strings.HasPrefix(typ.Name(), name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNullable(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Struct && typ.PkgPath() == enumPkgPath && strings.HasPrefix(typ.Name(), "Nullable[")
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -0,0 +1,167 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/xybor-x/enum/internal/core"
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// The sentinel errors of the failures of conversions, serialization and
+// deserialization functions, which can be matched by errors.Is. The messages
+// of the returned errors contain the message of their sentinel, e.g.
+// "enum Role: unknown string moderator" for ErrUnknownString.
+var (
+	// ErrUnknownString is matched by the errors about a string which does not
+	// correspond to any value of the enum type.
+	ErrUnknownString = errors.New("unknown string")
+
+	// ErrUnknownNumber is matched by the errors about a number which does not
+	// correspond to any value of the enum type.
+	ErrUnknownNumber = errors.New("unknown number")
+
+	// ErrInvalidEnum is matched by the errors about an invalid enum value, e.g.
+	// returned by MarshalJSON or ValueSQL.
+	ErrInvalidEnum = errors.New("invalid value")
+
+	// ErrUnsupportedScanType is matched by the errors about an input of an
+	// unsupported type, e.g. returned by ScanSQL.
+	ErrUnsupportedScanType = errors.New("not support type")
+)
+
+// ValueListingOption customizes the value listing of SetErrorValueListing.
+type ValueListingOption func(*mtkey.ValueListingConfig)
+
+// WithDescriptions includes the description of each value (see MapDesc) in the
+// value listing.
+func WithDescriptions() ValueListingOption {
+	return func(c *mtkey.ValueListingConfig) {
+		c.WithDescriptions = true
+	}
+}
+
+// SetErrorValueListing configures whether the errors about an unknown string
+// of the enum type (e.g. returned by UnmarshalJSON or ScanSQL) list all valid
+// values, for example:
+//
+//	enum Role: unknown string moderator (allowed values: user, admin — full administrative access)
+func SetErrorValueListing[Enum any](enabled bool, opts ...ValueListingOption) {
+	core.CheckMutable[Enum](core.MetadataMutation, "configure the value listing")
+
+	config := mtkey.ValueListingConfig{Enabled: enabled}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	registry.Set(mtkey.ValueListing[Enum](), config)
+}
+
+// UnknownStringError is the error about a string which is not the string
+// representation of any value of the enum type.
+type UnknownStringError struct {
+	// Type is the true name of the enum type (see TrueNameOf).
+	Type string
+
+	// Input is the unknown string.
+	Input string
+
+	// allowed is the value listing (see SetErrorValueListing), nil if
+	// disabled.
+	allowed []string
+}
+
+func (e *UnknownStringError) Error() string {
+	if e.allowed == nil {
+		return fmt.Sprintf("enum %s: unknown string %s", e.Type, e.Input)
+	}
+
+	return fmt.Sprintf("enum %s: unknown string %s (allowed values: %s)",
+		e.Type, e.Input, strings.Join(e.allowed, ", "))
+}
+
+// As allows errors.As to extract the error as a *ParseError, like the errors
+// about unknown numbers.
+func (e *UnknownStringError) As(target any) bool {
+	if p, ok := target.(**ParseError); ok {
+		*p = &ParseError{Type: e.Type, Input: e.Input, Allowed: e.allowed}
+		return true
+	}
+
+	return false
+}
+
+// Is allows errors.Is to match the error with ErrUnknownString.
+func (e *UnknownStringError) Is(target error) bool {
+	return target == ErrUnknownString
+}
+
+// ParseError is the error about an input which does not correspond to any
+// value of the enum type, returned by ParseString and ParseNumber. The errors
+// of all deserialization functions about unknown strings and numbers can be
+// extracted as a *ParseError by errors.As.
+type ParseError struct {
+	// Type is the true name of the enum type (see TrueNameOf).
+	Type string
+
+	// Input is the unknown string, or the unknown number.
+	Input any
+
+	// Allowed is the string representations of the valid values, nil if they
+	// are not listed (see SetErrorValueListing).
+	Allowed []string
+}
+
+func (e *ParseError) Error() string {
+	if e.Allowed == nil {
+		return fmt.Sprintf("enum %s: %v %v", e.Type, e.sentinel(), e.Input)
+	}
+
+	return fmt.Sprintf("enum %s: %v %v (allowed values: %s)",
+		e.Type, e.sentinel(), e.Input, strings.Join(e.Allowed, ", "))
+}
+
+// Is allows errors.Is to match the error with ErrUnknownString or
+// ErrUnknownNumber, depending on the input.
+func (e *ParseError) Is(target error) bool {
+	return target == e.sentinel()
+}
+
+func (e *ParseError) sentinel() error {
+	if _, ok := e.Input.(string); ok {
+		return ErrUnknownString
+	}
+
+	return ErrUnknownNumber
+}
+
+// unknownNumberError returns the error about an unknown number of the enum
+// type.
+func unknownNumberError[Enum any](n any) error {
+	return &ParseError{Type: TrueNameOf[Enum](), Input: n}
+}
+
+// unknownStringError returns the error about an unknown string of the enum
+// type.
+func unknownStringError[Enum any](s string) error {
+	err := &UnknownStringError{Type: TrueNameOf[Enum](), Input: s}
+
+	config := registry.Get(mtkey.ValueListing[Enum]())
+	if !config.Enabled {
+		return err
+	}
+
+	values := DescribedValues[Enum]()
+	err.allowed = make([]string, len(values))
+	for i, value := range values {
+		if config.WithDescriptions {
+			err.allowed[i] = value.String()
+		} else {
+			err.allowed[i] = value.Name
+		}
+	}
+
+	return err
+}
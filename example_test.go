@@ -178,3 +178,74 @@ func ExampleNullable() {
 	// {"id":0,"name":"","role":null}
 	// {"id":0,"name":"tester","role":"admin"}
 }
+
+func ExampleNullable_OrElse() {
+	type Role int
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+		_         = enum.Finalize[Role]()
+	)
+
+	// Instead of:
+	//
+	//	role := RoleUser
+	//	if n.Valid {
+	//		role = n.Enum
+	//	}
+	fmt.Println(enum.ToString(NullRole{}.OrElse(RoleUser)))
+	fmt.Println(enum.ToString(NullRole{Enum: RoleAdmin, Valid: true}.OrElse(RoleUser)))
+
+	// Output:
+	// user
+	// admin
+}
+
+func ExampleNullable_Equal() {
+	type Role int
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+		_         = enum.Finalize[Role]()
+	)
+
+	// Instead of:
+	//
+	//	n1.Valid == n2.Valid && (!n1.Valid || n1.Enum == n2.Enum)
+	fmt.Println(NullRole{}.Equal(NullRole{Enum: RoleAdmin}))
+	fmt.Println(NullRole{Enum: RoleUser, Valid: true}.Equal(NullRole{}))
+
+	// Instead of:
+	//
+	//	n.Valid && n.Enum == RoleUser
+	fmt.Println(NullRole{}.EqualValue(RoleUser))
+
+	// Output:
+	// true
+	// false
+	// false
+}
+
+func ExampleCoalesceNullable() {
+	type Role int
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		_         = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+		_         = enum.Finalize[Role]()
+	)
+
+	var fromRequest, fromProfile NullRole
+	fromProfile = NullRole{Enum: RoleAdmin, Valid: true}
+
+	role := enum.CoalesceNullable(fromRequest, fromProfile)
+	fmt.Println(role.Valid, enum.ToString(role.Enum))
+
+	// Output:
+	// true admin
+}
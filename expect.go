@@ -0,0 +1,63 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// Expect records that the given string literals must be string
+// representations of the enum type, e.g. literals passed to MustFromString in
+// a routing table. It returns true, so it can be called in a package-level var
+// block next to the code using the literals:
+//
+//	var _ = enum.Expect[Role]("admin", "user")
+//
+// The literals are verified when the enum type is finalized, which panics
+// with the location of every failed expectation, so a typo is caught at
+// startup instead of at the first execution of the branch using it. Because
+// package-level variables may be initialized before the enum values, nothing
+// is verified before that. Use CheckExpectations to verify enum types which
+// are never finalized.
+//
+// Note that this function is not thread-safe and should only be called during
+// initialization or other safe execution points to avoid race conditions.
+func Expect[Enum any](literals ...string) bool {
+	site := registrationSite()
+	check := func() []error {
+		var errs []error
+		for _, literal := range literals {
+			// Don't use FromString, which would record a usage of the value.
+			if _, ok := registry.Get2(mtkey.Repr2Enum[Enum](literal)); !ok {
+				errs = append(errs, fmt.Errorf("enum %s: expected string %s is not registered (expected at %s)",
+					TrueNameOf[Enum](), literal, site))
+			}
+		}
+
+		return errs
+	}
+
+	checks := registry.Get(mtkey.Expectations())
+	registry.Set(mtkey.Expectations(), append(checks, check))
+
+	addFinalizeHook(func([]Enum) {
+		if errs := check(); len(errs) > 0 {
+			panic(errors.Join(errs...).Error())
+		}
+	})
+
+	return true
+}
+
+// CheckExpectations verifies the expectations recorded by Expect of all enum
+// types, returning an error for every literal which is not registered.
+func CheckExpectations() []error {
+	var errs []error
+	for _, check := range registry.Get(mtkey.Expectations()) {
+		errs = append(errs, check()...)
+	}
+
+	return errs
+}
@@ -0,0 +1,144 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/xybor-x/enum/internal/xstring"
+)
+
+// maxFieldErrorValues caps the number of allowed values of a FieldError.
+const maxFieldErrorValues = 20
+
+// FieldError is the error about a field of a request which is not the string
+// representation of any value of the enum type.
+type FieldError struct {
+	// Field is the name of the field.
+	Field string
+
+	// Value is the raw input of the field.
+	Value string
+
+	// Type is the true name of the enum type (see TrueNameOf).
+	Type string
+
+	// Allowed is the string representations of the values of the enum type,
+	// capped at 20 values.
+	Allowed []string
+
+	// Suggestion is the allowed value closest to the raw input, or an empty
+	// string if none of them is close enough.
+	Suggestion string
+
+	err error
+}
+
+func (e *FieldError) Error() string {
+	if e.Suggestion == "" {
+		return fmt.Sprintf("field %s: %v", e.Field, e.err)
+	}
+
+	return fmt.Sprintf("field %s: %v (did you mean %s?)", e.Field, e.err, e.Suggestion)
+}
+
+// Unwrap returns the underlying UnknownStringError.
+func (e *FieldError) Unwrap() error {
+	return e.err
+}
+
+// MarshalJSON encodes the error as an object for API responses, for example:
+//
+//	{"field":"role","value":"amdin","allowed":["user","admin"],"suggestion":"admin"}
+func (e *FieldError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Field      string   `json:"field"`
+		Value      string   `json:"value"`
+		Allowed    []string `json:"allowed"`
+		Suggestion string   `json:"suggestion,omitempty"`
+	}{e.Field, e.Value, e.Allowed, e.Suggestion})
+}
+
+// FieldErrors is the error about multiple fields of a request, returned by
+// CollectFieldErrors.
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the errors of all fields.
+func (e FieldErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// MarshalJSON encodes the errors as a response body, for example:
+//
+//	{"errors":[{"field":"role","value":"amdin","allowed":["user","admin"],"suggestion":"admin"}]}
+func (e FieldErrors) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*FieldError `json:"errors"`
+	}{e})
+}
+
+// DecodeField returns the enum value of the raw string of a request field. If
+// the string is unknown, it returns a FieldError with the name of the field.
+//
+//	role, roleErr := enum.DecodeField[Role](req.Role, "role")
+//	status, statusErr := enum.DecodeField[Status](req.Status, "status")
+//	if err := enum.CollectFieldErrors(roleErr, statusErr); err != nil {
+//		// Respond 422 with the JSON encoding of err.
+//	}
+func DecodeField[Enum any](raw string, fieldName string) (Enum, *FieldError) {
+	if enum, ok := FromString[Enum](raw); ok {
+		return enum, nil
+	}
+
+	values := AllRef[Enum]()
+	names := make([]string, len(values))
+	for i, value := range values {
+		names[i] = ToString(value)
+	}
+
+	suggestion, _ := xstring.Closest(raw, names, max(1, len(raw)/3))
+	if len(names) > maxFieldErrorValues {
+		names = names[:maxFieldErrorValues]
+	}
+
+	var zero Enum
+	return zero, &FieldError{
+		Field:      fieldName,
+		Value:      raw,
+		Type:       TrueNameOf[Enum](),
+		Allowed:    names,
+		Suggestion: suggestion,
+		err:        unknownStringError[Enum](raw),
+	}
+}
+
+// CollectFieldErrors joins the non-nil field errors into a FieldErrors. It
+// returns nil if all of them are nil.
+func CollectFieldErrors(errs ...*FieldError) error {
+	var fieldErrs FieldErrors
+	for _, err := range errs {
+		if err != nil {
+			fieldErrs = append(fieldErrs, err)
+		}
+	}
+
+	if len(fieldErrs) == 0 {
+		return nil
+	}
+
+	return fieldErrs
+}
@@ -0,0 +1,122 @@
+package enum
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// OnFinalize registers a callback invoked exactly once when the enum type is
+// finalized, receiving all values of the type. If the type was already
+// finalized, the callback is invoked immediately.
+//
+// Callbacks are invoked in their registration order. A panic in a callback is
+// re-raised with the file and line where the callback was registered.
+//
+// Note that this function is not thread-safe and should only be called during
+// initialization or other safe execution points to avoid race conditions.
+func OnFinalize[Enum any](fn func(values []Enum)) {
+	site := registrationSite()
+	hook := func(values []Enum) {
+		defer recoverHookPanic(TrueNameOf[Enum](), "OnFinalize", site)
+		fn(values)
+	}
+
+	addFinalizeHook(hook)
+}
+
+// addFinalizeHook registers a hook invoked when the enum type is finalized, or
+// immediately if it was already finalized.
+func addFinalizeHook[Enum any](hook func(values []Enum)) {
+	if registry.Get(mtkey.IsFinalized[Enum]()) {
+		hook(All[Enum]())
+		return
+	}
+
+	hooks := registry.Get(mtkey.FinalizeHooks[Enum]())
+	registry.Set(mtkey.FinalizeHooks[Enum](), append(hooks, hook))
+}
+
+// OnAnyFinalize registers a callback invoked every time an enum type is
+// finalized, receiving the true name of the type (see TrueNameOf). Types
+// finalized before the registration are not reported. It returns a function
+// which unregisters the callback.
+//
+// Callbacks are invoked in their registration order, after the callbacks
+// registered by OnFinalize for that type.
+//
+// Note that this function is not thread-safe and should only be called during
+// initialization or other safe execution points to avoid race conditions.
+func OnAnyFinalize(fn func(typeName string)) (remove func()) {
+	site := registrationSite()
+	hook := func(typeName string) {
+		defer recoverHookPanic(typeName, "OnAnyFinalize", site)
+		fn(typeName)
+	}
+
+	hooks := registry.Get(mtkey.AnyFinalizeHooks())
+	registry.Set(mtkey.AnyFinalizeHooks(), append(hooks[:len(hooks):len(hooks)], &hook))
+
+	return func() {
+		hooks := registry.Get(mtkey.AnyFinalizeHooks())
+		remaining := make([]*func(string), 0, len(hooks))
+		for _, h := range hooks {
+			if h != &hook {
+				remaining = append(remaining, h)
+			}
+		}
+
+		registry.Set(mtkey.AnyFinalizeHooks(), remaining)
+	}
+}
+
+// runFinalizeHooks invokes all callbacks registered for the enum type and all
+// global ones. A panicking callback doesn't prevent the next ones from being
+// invoked: the panics are re-raised together once all callbacks returned.
+func runFinalizeHooks[Enum any]() {
+	hooks := registry.Get(mtkey.FinalizeHooks[Enum]())
+	registry.Delete(mtkey.FinalizeHooks[Enum]())
+
+	var panics []string
+	run := func(hook func()) {
+		defer func() {
+			if r := recover(); r != nil {
+				panics = append(panics, fmt.Sprint(r))
+			}
+		}()
+
+		hook()
+	}
+
+	for _, hook := range hooks {
+		run(func() { hook(All[Enum]()) })
+	}
+
+	for _, hook := range registry.Get(mtkey.AnyFinalizeHooks()) {
+		run(func() { (*hook)(TrueNameOf[Enum]()) })
+	}
+
+	if len(panics) > 0 {
+		panic(strings.Join(panics, "; "))
+	}
+}
+
+// registrationSite returns the location of the caller of the public function
+// which calls registrationSite.
+func registrationSite() string {
+	_, file, line, ok := runtime.Caller(2)
+	if !ok {
+		return "unknown"
+	}
+
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+func recoverHookPanic(typeName, kind, site string) {
+	if r := recover(); r != nil {
+		panic(fmt.Sprintf("enum %s: %s callback registered at %s panicked: %v", typeName, kind, site, r))
+	}
+}
@@ -0,0 +1,51 @@
+package enum
+
+// FlagValue adapts an enum variable to the flag.Value and flag.Getter
+// interfaces of the flag package (see Flag). It also implements pflag.Value of
+// spf13/pflag, and so can be used with cobra.
+type FlagValue[Enum any] struct {
+	value *Enum
+}
+
+// Flag returns a flag.Value which parses the string representations into the
+// enum variable:
+//
+//	var role = RoleUser // default value
+//	flag.Var(enum.Flag(&role), "role", "the role of the user")
+func Flag[Enum any](value *Enum) *FlagValue[Enum] {
+	return &FlagValue[Enum]{value: value}
+}
+
+// String returns the string representation of the current value, or <nil> if
+// it is invalid.
+func (f *FlagValue[Enum]) String() string {
+	// The flag package calls String on a zero FlagValue to check whether the
+	// default value is the zero value.
+	if f == nil || f.value == nil {
+		return ""
+	}
+
+	return ToString(*f.value)
+}
+
+// Set parses the string representation into the enum variable. The error about
+// an unknown string lists the allowed values.
+func (f *FlagValue[Enum]) Set(s string) error {
+	enum, ok := FromString[Enum](s)
+	if !ok {
+		return &UnknownStringError{Type: TrueNameOf[Enum](), Input: s, allowed: AllStrings[Enum]()}
+	}
+
+	*f.value = enum
+	return nil
+}
+
+// Type returns the name of the enum type, shown by pflag in the usage.
+func (f *FlagValue[Enum]) Type() string {
+	return NameOf[Enum]()
+}
+
+// Get returns the current value of the enum variable.
+func (f *FlagValue[Enum]) Get() any {
+	return *f.value
+}
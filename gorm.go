@@ -0,0 +1,44 @@
+package enum
+
+import (
+	"reflect"
+
+	"github.com/xybor-x/enum/internal/core"
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// GormDataType returns the column type of the enum type for GORM migrations:
+// the type set by SetGormDataType, else "float", "uint" or "int" (after the
+// kind of the enum type, e.g. "float" for WrapFloatEnum) if SetSQLFormat is
+// SQLNumber, else "string". The enum wrappers and Nullable implement the
+// GormDataTypeInterface of GORM with it, without importing GORM.
+//
+// GORM maps these generic types to the column types of each dialect, so the
+// enum types don't implement GormDBDataTypeInterface, which would require
+// importing GORM. Use SetGormDataType for a dialect-specific type.
+func GormDataType[Enum any]() string {
+	if typ, ok := registry.Get2(mtkey.GormDataType[Enum]()); ok {
+		return typ
+	}
+
+	if registry.Get(mtkey.SQLNumberFormat[Enum]()) {
+		switch reflect.TypeOf((*Enum)(nil)).Elem().Kind() {
+		case reflect.Float32, reflect.Float64:
+			return "float"
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return "uint"
+		default:
+			return "int"
+		}
+	}
+
+	return "string"
+}
+
+// SetGormDataType sets the column type returned by GormDataType, e.g. the name
+// of a PostgreSQL enum type (see PostgresType) or "varchar(16)".
+func SetGormDataType[Enum any](typ string) {
+	core.CheckMutable[Enum](core.MetadataMutation, "set the GORM data type")
+	registry.Set(mtkey.GormDataType[Enum](), typ)
+}
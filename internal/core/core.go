@@ -7,17 +7,22 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/xybor-x/enum/internal/mtkey"
-	"github.com/xybor-x/enum/internal/mtmap"
 	"github.com/xybor-x/enum/internal/xmath"
 	"github.com/xybor-x/enum/internal/xreflect"
+	"github.com/xybor-x/enum/registry"
 )
 
 func GetAvailableEnumValue[Enum any]() int64 {
+	return availableEnumValue[Enum](nil)
+}
+
+func availableEnumValue[Enum any](s *stage) int64 {
 	id := int64(0)
 	for {
-		if _, ok := mtmap.Get2(mtkey.Repr2Enum[Enum](id)); !ok {
+		if _, ok := stageGet2(s, mtkey.Repr2Enum[Enum](id)); !ok {
 			break
 		}
 		id++
@@ -27,6 +32,16 @@ func GetAvailableEnumValue[Enum any]() int64 {
 }
 
 func GetNumericRepresentation(reprs []any) any {
+	if HasOptions(reprs) {
+		for _, repr := range reprs {
+			if opt, ok := repr.(Option); ok && opt.kind == numOption {
+				return opt.value
+			}
+		}
+
+		return nil
+	}
+
 	var numericRepr any
 
 	for _, repr := range reprs {
@@ -45,6 +60,16 @@ func GetNumericRepresentation(reprs []any) any {
 }
 
 func GetStringRepresentation(reprs []any) (string, bool) {
+	if HasOptions(reprs) {
+		for _, repr := range reprs {
+			if opt, ok := repr.(Option); ok && opt.kind == strOption {
+				return opt.value.(string), true
+			}
+		}
+
+		return "", false
+	}
+
 	var strRepr string
 	var hasStrRepr bool
 
@@ -75,7 +100,7 @@ func RemoveStringRepresentation(reprs []any) []any {
 
 	for i, repr := range reprs {
 		switch {
-		case xreflect.IsPrimitiveString(repr):
+		case xreflect.IsPrimitiveString(repr), isOption(repr, strOption):
 			strReprIdx = i
 		}
 	}
@@ -92,7 +117,7 @@ func RemoveNumericRepresentation(reprs []any) []any {
 
 	for i, repr := range reprs {
 		switch {
-		case xreflect.IsPrimitiveNumber(repr):
+		case xreflect.IsPrimitiveNumber(repr), isOption(repr, numOption):
 			strReprIdx = i
 		}
 	}
@@ -106,113 +131,206 @@ func RemoveNumericRepresentation(reprs []any) []any {
 
 // MapAny maps the enum value to its representations.
 func MapAny[Enum any](enum Enum, reprs []any) Enum {
-	if mtmap.Get(mtkey.IsFinalized[Enum]()) {
-		panic(fmt.Sprintf("enum %s: the enum was already finalized", TrueNameOf[Enum]()))
-	}
+	return mapAny(enum, reprs, false)
+}
 
-	var strRepr string
-	var hasStrRepr bool
-	var hasPrimitiveStr bool
+// MapAnyAuto is similar to MapAny, but the numeric representation of the enum
+// value was assigned by GetAvailableEnumValue instead of the user.
+func MapAnyAuto[Enum any](enum Enum, reprs []any) Enum {
+	return mapAny(enum, reprs, true)
+}
 
-	var numericRepr any
-	var hasPrimitiveNumeric bool
+func mapAny[Enum any](enum Enum, reprs []any, autoNumbered bool) Enum {
+	CheckMutable[Enum](ValueMutation, "map a value")
 
-	if xreflect.IsNumber(enum) {
-		numericRepr = enum
-		hasPrimitiveNumeric = true
+	recordRegisteringPackage[Enum]()
+	registerType[Enum]()
+
+	stageMapAny(nil, enum, reprs, autoNumbered, func(c Conflict) { panic(c.Reason) })
+
+	return enum
+}
+
+// stageMapAny validates the mapping of the enum value against the registry
+// and the writes of the stage, then stages its writes. Every conflict is
+// reported, the writes of a conflicting mapping must not be committed.
+func stageMapAny[Enum any](s *stage, enum Enum, reprs []any, autoNumbered bool, report func(Conflict)) {
+	conflict := func(existing any, format string, args ...any) {
+		report(Conflict{Value: enum, Existing: existing, Reason: fmt.Sprintf(format, args...)})
 	}
 
-	if xreflect.IsString(enum) {
-		strRepr = xreflect.Convert[string](enum)
-		hasStrRepr = true
-		hasPrimitiveStr = true
+	if reason := mutationConflict[Enum](ValueMutation, "map a value"); reason != "" {
+		conflict(nil, "%s", reason)
 	}
 
-	for _, repr := range reprs {
-		switch {
-		case xreflect.IsPrimitiveNumber(repr):
-			if hasPrimitiveNumeric {
-				panic(fmt.Sprintf("enum %s (%#v): multiple primitive numerics are provided (%v, %v)",
-					TrueNameOf[Enum](), enum, numericRepr, repr))
-			}
+	stageMapSpec(s, enum, parseReprs(enum, reprs, conflict), autoNumbered, conflict)
+}
 
-			numericRepr = repr
-			hasPrimitiveNumeric = true
+// stageMapSpec is stageMapAny for classified representations.
+func stageMapSpec[Enum any](
+	s *stage, enum Enum, spec Spec, autoNumbered bool,
+	conflict func(existing any, format string, args ...any),
+) {
+	if xreflect.IsNumber(enum) && spec.Num != nil {
+		conflict(nil, "enum %s (%#v): multiple primitive numerics are provided (%v, %v)",
+			TrueNameOf[Enum](), enum, enum, spec.Num)
+		spec.Num = nil
+	}
 
-		case xreflect.IsPrimitiveString(repr):
-			if hasPrimitiveStr {
-				panic(fmt.Sprintf("enum %s (%#v): multiple primitive strings are provided (%v, %v)",
-					TrueNameOf[Enum](), enum, strRepr, repr))
-			}
+	if xreflect.IsString(enum) && spec.HasStr {
+		conflict(nil, "enum %s (%#v): multiple primitive strings are provided (%v, %v)",
+			TrueNameOf[Enum](), enum, enum, spec.Str)
+		spec.HasStr = false
+	}
 
-			strRepr = xreflect.Convert[string](repr)
-			hasStrRepr = true
-			hasPrimitiveStr = true
+	var numericRepr any
+	if xreflect.IsNumber(enum) {
+		numericRepr = enum
+	} else {
+		numericRepr = spec.NumRepr()
+	}
 
-		default:
-			if v, ok := mtmap.Get2(mtkey.Repr2Enum[Enum](repr)); ok {
-				panic(fmt.Sprintf("enum %s (%#v): representation %v of %T was already mapped to %v",
-					TrueNameOf[Enum](), enum, repr, repr, v))
-			}
+	strRepr, hasStrRepr := spec.StrRepr()
+	if xreflect.IsString(enum) {
+		strRepr, hasStrRepr = xreflect.Convert[string](enum), true
+	}
 
-			if _, ok := mtmap.Get2(mtkey.Enum2ReprWith(enum, repr)); ok {
-				panic(fmt.Sprintf("enum %s (%#v): do not map type %s twice",
-					TrueNameOf[Enum](), enum, reflect.TypeOf(repr).Name()))
-			}
+	// A value without numeric representation is numbered below.
+	if numericRepr == nil {
+		autoNumbered = true
+	}
 
-			if !hasStrRepr {
-				if xreflect.IsImplement[fmt.Stringer](repr) {
-					strRepr = repr.(fmt.Stringer).String()
-					hasStrRepr = true
-				} else if xreflect.IsString(repr) {
-					strRepr = xreflect.Convert[string](repr)
-					hasStrRepr = true
-				}
-			}
+	if !numberingStyleAllowed[Enum](s, autoNumbered) {
+		conflict(nil, "enum %s (%#v): cannot mix auto-assigned and explicit numbers "+
+			"(see RequireSingleNumberingStyle)", TrueNameOf[Enum](), enum)
+	}
 
-			if numericRepr == nil && xreflect.IsNumber(repr) {
-				numericRepr = repr
-			}
+	for _, repr := range spec.Reprs {
+		if v, ok := stageGet2(s, mtkey.Repr2Enum[Enum](repr)); ok {
+			conflict(v, "enum %s (%#v): representation %v of %T was already mapped to %v%s",
+				TrueNameOf[Enum](), enum, repr, repr, v, conflictPackages[Enum]())
+		}
 
-			mtmap.Set(mtkey.Enum2ReprWith(enum, repr), repr)
-			mtmap.Set(mtkey.Repr2Enum[Enum](repr), enum)
+		if _, ok := stageGet2(s, mtkey.Enum2ReprWith(enum, repr)); ok {
+			conflict(enum, "enum %s (%#v): do not map type %s twice%s",
+				TrueNameOf[Enum](), enum, reflect.TypeOf(repr).Name(), conflictPackages[Enum]())
 		}
+
+		stageSet(s, mtkey.Enum2ReprWith(enum, repr), repr)
+		stageSet(s, mtkey.Repr2Enum[Enum](repr), enum)
 	}
 
 	if !hasStrRepr {
-		panic(fmt.Sprintf("enum %s (%#v): not found any string representation", TrueNameOf[Enum](), enum))
+		conflict(nil, "enum %s (%#v): not found any string representation", TrueNameOf[Enum](), enum)
 	}
 
 	if numericRepr == nil {
-		numericRepr = GetAvailableEnumValue[Enum]()
+		numericRepr = availableEnumValue[Enum](s)
+	}
+
+	stageEnumNumber(s, enum, numericRepr, conflict)
+
+	if !hasStrRepr {
+		return
 	}
 
-	mapEnumNumber(enum, numericRepr)
+	if v, ok := stageGet2(s, mtkey.Repr2Enum[Enum](strRepr)); ok {
+		conflict(v, "enum %s (%#v): string %s was already mapped to %v%s",
+			TrueNameOf[Enum](), enum, strRepr, v, conflictPackages[Enum]())
+	}
 
-	if v, ok := mtmap.Get2(mtkey.Repr2Enum[Enum](strRepr)); ok {
-		panic(fmt.Sprintf("enum %s (%#v): string %s was already mapped to %v",
-			TrueNameOf[Enum](), enum, strRepr, v))
+	if _, ok := stageGet2(s, mtkey.Enum2Repr[Enum, string](enum)); ok {
+		conflict(enum, "enum %s (%#v): do not map string twice%s",
+			TrueNameOf[Enum](), enum, conflictPackages[Enum]())
 	}
 
-	if _, ok := mtmap.Get2(mtkey.Enum2Repr[Enum, string](enum)); ok {
-		panic(fmt.Sprintf("enum %s (%#v): do not map string twice", TrueNameOf[Enum](), enum))
+	if normalize := stageGet(s, mtkey.StringNormalizer[Enum]()); normalize != nil {
+		key := normalize(strRepr)
+		if v, ok := stageGet2(s, mtkey.Normalized2Enum[Enum](key)); ok {
+			other, _ := stageGet(s, mtkey.Enum2Repr[Enum, string](v)).(string)
+			conflict(v, "enum %s (%#v): string %s collides with string %s of %v under the string matcher%s",
+				TrueNameOf[Enum](), enum, strRepr, other, v, conflictPackages[Enum]())
+		}
+
+		stageSet(s, mtkey.Normalized2Enum[Enum](key), enum)
 	}
 
-	mtmap.Set(mtkey.Enum2JSON(enum), strconv.Quote(strRepr))
-	mtmap.Set(mtkey.Enum2Repr[Enum, string](enum), any(strRepr))
-	mtmap.Set(mtkey.Repr2Enum[Enum](strRepr), enum)
+	// Types sharing a vocabulary share the backing storage of their strings.
+	strRepr = s.intern(strRepr)
+	stageSet(s, mtkey.Enum2JSON(enum), s.intern(strconv.Quote(strRepr)))
+	stageSet(s, mtkey.Enum2Repr[Enum, []byte](enum), any([]byte(strRepr)))
+	stageSet(s, mtkey.Enum2Repr[Enum, string](enum), any(strRepr))
+	stageSet(s, mtkey.Repr2Enum[Enum](strRepr), enum)
+	stageSet(s, mtkey.EnumUsage(enum), new(atomic.Uint32))
+	stageStringsChanged[Enum](s)
+
+	for _, alias := range spec.Aliases {
+		stageAlias(s, enum, alias, conflict)
+	}
 
-	allVals := mtmap.Get(mtkey.AllEnums[Enum]())
+	allVals := stageGet(s, mtkey.AllEnums[Enum]())
+	if s != nil {
+		// Never append into the spare capacity of the registered slice.
+		allVals = allVals[:len(allVals):len(allVals)]
+	}
 	allVals = append(allVals, enum)
-	mtmap.Set(mtkey.AllEnums[Enum](), allVals)
+	stageSet(s, mtkey.AllEnums[Enum](), allVals)
 
-	return enum
+	allStrs := stageGet(s, mtkey.AllStrings[Enum]())
+	if s != nil {
+		allStrs = allStrs[:len(allStrs):len(allStrs)]
+	}
+	allStrs = append(allStrs, strRepr)
+	stageSet(s, mtkey.AllStrings[Enum](), allStrs)
+
+	stageNumberingStyle(s, enum, autoNumbered)
+}
+
+// MapAlias maps an additional string to a mapped enum value, which is resolved
+// as the string representation but never emitted.
+func MapAlias[Enum any](enum Enum, alias string) {
+	stageAlias(nil, enum, alias, func(_ any, format string, args ...any) {
+		panic(fmt.Sprintf(format, args...))
+	})
+}
+
+// stageAlias maps an additional string to the enum value, which is resolved
+// as the string representation but never emitted.
+func stageAlias[Enum any](s *stage, enum Enum, alias string, conflict func(existing any, format string, args ...any)) {
+	if v, ok := stageGet2(s, mtkey.Repr2Enum[Enum](alias)); ok {
+		conflict(v, "enum %s (%#v): alias %s was already mapped to %v%s",
+			TrueNameOf[Enum](), enum, alias, v, conflictPackages[Enum]())
+		return
+	}
+
+	if normalize := stageGet(s, mtkey.StringNormalizer[Enum]()); normalize != nil {
+		key := normalize(alias)
+		if v, ok := stageGet2(s, mtkey.Normalized2Enum[Enum](key)); ok {
+			conflict(v, "enum %s (%#v): alias %s collides with a string of %v under the string matcher%s",
+				TrueNameOf[Enum](), enum, alias, v, conflictPackages[Enum]())
+			return
+		}
+
+		stageSet(s, mtkey.Normalized2Enum[Enum](key), enum)
+	}
+
+	alias = s.intern(alias)
+	aliases := stageGet(s, mtkey.Enum2Aliases(enum))
+	stageSet(s, mtkey.Enum2Aliases(enum), append(aliases[:len(aliases):len(aliases)], alias))
+	stageSet(s, mtkey.Repr2Enum[Enum](alias), enum)
+	stageStringsChanged[Enum](s)
+}
+
+// stageStringsChanged invalidates the indexes derived from the strings of the
+// enum type, e.g. the index of FromStringFold.
+func stageStringsChanged[Enum any](s *stage) {
+	stageSet(s, mtkey.StringsRevision[Enum](), stageGet(s, mtkey.StringsRevision[Enum]())+1)
 }
 
 var advancedEnumNames = []string{"WrapEnum", "WrapUintEnum", "WrapFloatEnum", "SafeEnum"}
 
 func NameOf[T any]() string {
-	if name, ok := mtmap.Get2(mtkey.NameOf[T]()); ok {
+	if name, ok := registry.Get2(mtkey.NameOf[T]()); ok {
 		return name
 	}
 
@@ -224,12 +342,12 @@ func NameOf[T any]() string {
 		}
 	}
 
-	mtmap.Set(mtkey.NameOf[T](), name)
+	registry.Set(mtkey.NameOf[T](), name)
 	return name
 }
 
 func TrueNameOf[T any]() string {
-	if name, ok := mtmap.Get2(mtkey.TrueNameOf[T]()); ok {
+	if name, ok := registry.Get2(mtkey.TrueNameOf[T]()); ok {
 		return name
 	}
 
@@ -241,7 +359,7 @@ func TrueNameOf[T any]() string {
 		}
 	}
 
-	mtmap.Set(mtkey.TrueNameOf[T](), name)
+	registry.Set(mtkey.TrueNameOf[T](), name)
 	return name
 }
 
@@ -264,17 +382,28 @@ func capitalizeFirst(s string) string {
 	return strings.ToUpper(string(s[0])) + s[1:]
 }
 
-// mapEnumNumber maps the enum to all its number representations (including
+// stageEnumNumber maps the enum to all its number representations (including
 // signed and unsigned integers, floating-point numbers) and vice versa.
-func mapEnumNumber[Enum any](enum Enum, n any) {
-	if v, ok := mtmap.Get2(mtkey.Repr2Enum[Enum](n)); ok {
-		panic(fmt.Sprintf("enum %s (%v): number %v was already mapped to %v",
-			reflect.TypeOf(enum).Name(), enum, n, v))
+func stageEnumNumber[Enum any](s *stage, enum Enum, n any, conflict func(existing any, format string, args ...any)) {
+	if v, ok := stageGet2(s, mtkey.Repr2Enum[Enum](n)); ok {
+		conflict(v, "enum %s (%v): %s%s",
+			TrueNameOf[Enum](), enum, numberConflictMessage(s, n, v), conflictPackages[Enum]())
+		return
 	}
 
 	// The mapping to float32 always exists in all cases.
-	if _, ok := mtmap.Get2(mtkey.Enum2Repr[Enum, float32](enum)); ok {
-		panic(fmt.Sprintf("enum %s (%v): do not map number twice", reflect.TypeOf(enum).Name(), enum))
+	if _, ok := stageGet2(s, mtkey.Enum2Repr[Enum, float32](enum)); ok {
+		// Numeric enums are their own numbers, so the enum value may be the
+		// auto-assigned one.
+		if stageGet(s, mtkey.AutoNumbered(enum)) {
+			conflict(enum, "enum %s (%v): %s%s",
+				TrueNameOf[Enum](), enum, numberConflictMessage(s, n, enum), conflictPackages[Enum]())
+			return
+		}
+
+		conflict(enum, "enum %s (%v): do not map number twice%s",
+			TrueNameOf[Enum](), enum, conflictPackages[Enum]())
+		return
 	}
 
 	// Only map the enum to integers if the enum is represented by integer
@@ -291,39 +420,39 @@ func mapEnumNumber[Enum any](enum Enum, n any) {
 
 	if mapInteger {
 		// Map enum to all signed integers.
-		mtmap.Set(mtkey.Enum2Repr[Enum, int](enum), any(xreflect.Convert[int](n)))
-		mtmap.Set(mtkey.Enum2Repr[Enum, int8](enum), any(xreflect.Convert[int8](n)))
-		mtmap.Set(mtkey.Enum2Repr[Enum, int16](enum), any(xreflect.Convert[int16](n)))
-		mtmap.Set(mtkey.Enum2Repr[Enum, int32](enum), any(xreflect.Convert[int32](n)))
-		mtmap.Set(mtkey.Enum2Repr[Enum, int64](enum), any(xreflect.Convert[int64](n)))
+		stageSet(s, mtkey.Enum2Repr[Enum, int](enum), any(xreflect.Convert[int](n)))
+		stageSet(s, mtkey.Enum2Repr[Enum, int8](enum), any(xreflect.Convert[int8](n)))
+		stageSet(s, mtkey.Enum2Repr[Enum, int16](enum), any(xreflect.Convert[int16](n)))
+		stageSet(s, mtkey.Enum2Repr[Enum, int32](enum), any(xreflect.Convert[int32](n)))
+		stageSet(s, mtkey.Enum2Repr[Enum, int64](enum), any(xreflect.Convert[int64](n)))
 
 		// Map enum to all unsigned integers.
-		mtmap.Set(mtkey.Enum2Repr[Enum, uint](enum), any(xreflect.Convert[uint](n)))
-		mtmap.Set(mtkey.Enum2Repr[Enum, uint8](enum), any(xreflect.Convert[uint8](n)))
-		mtmap.Set(mtkey.Enum2Repr[Enum, uint16](enum), any(xreflect.Convert[uint16](n)))
-		mtmap.Set(mtkey.Enum2Repr[Enum, uint32](enum), any(xreflect.Convert[uint32](n)))
-		mtmap.Set(mtkey.Enum2Repr[Enum, uint64](enum), any(xreflect.Convert[uint64](n)))
+		stageSet(s, mtkey.Enum2Repr[Enum, uint](enum), any(xreflect.Convert[uint](n)))
+		stageSet(s, mtkey.Enum2Repr[Enum, uint8](enum), any(xreflect.Convert[uint8](n)))
+		stageSet(s, mtkey.Enum2Repr[Enum, uint16](enum), any(xreflect.Convert[uint16](n)))
+		stageSet(s, mtkey.Enum2Repr[Enum, uint32](enum), any(xreflect.Convert[uint32](n)))
+		stageSet(s, mtkey.Enum2Repr[Enum, uint64](enum), any(xreflect.Convert[uint64](n)))
 
 		// Map all signed integers to enum.
-		mtmap.Set(mtkey.Repr2Enum[Enum](xreflect.Convert[int](n)), enum)
-		mtmap.Set(mtkey.Repr2Enum[Enum](xreflect.Convert[int8](n)), enum)
-		mtmap.Set(mtkey.Repr2Enum[Enum](xreflect.Convert[int16](n)), enum)
-		mtmap.Set(mtkey.Repr2Enum[Enum](xreflect.Convert[int32](n)), enum)
-		mtmap.Set(mtkey.Repr2Enum[Enum](xreflect.Convert[int64](n)), enum)
+		stageSet(s, mtkey.Repr2Enum[Enum](xreflect.Convert[int](n)), enum)
+		stageSet(s, mtkey.Repr2Enum[Enum](xreflect.Convert[int8](n)), enum)
+		stageSet(s, mtkey.Repr2Enum[Enum](xreflect.Convert[int16](n)), enum)
+		stageSet(s, mtkey.Repr2Enum[Enum](xreflect.Convert[int32](n)), enum)
+		stageSet(s, mtkey.Repr2Enum[Enum](xreflect.Convert[int64](n)), enum)
 
 		// Map all unsigned integers to enum.
-		mtmap.Set(mtkey.Repr2Enum[Enum](xreflect.Convert[uint](n)), enum)
-		mtmap.Set(mtkey.Repr2Enum[Enum](xreflect.Convert[uint8](n)), enum)
-		mtmap.Set(mtkey.Repr2Enum[Enum](xreflect.Convert[uint16](n)), enum)
-		mtmap.Set(mtkey.Repr2Enum[Enum](xreflect.Convert[uint32](n)), enum)
-		mtmap.Set(mtkey.Repr2Enum[Enum](xreflect.Convert[uint64](n)), enum)
+		stageSet(s, mtkey.Repr2Enum[Enum](xreflect.Convert[uint](n)), enum)
+		stageSet(s, mtkey.Repr2Enum[Enum](xreflect.Convert[uint8](n)), enum)
+		stageSet(s, mtkey.Repr2Enum[Enum](xreflect.Convert[uint16](n)), enum)
+		stageSet(s, mtkey.Repr2Enum[Enum](xreflect.Convert[uint32](n)), enum)
+		stageSet(s, mtkey.Repr2Enum[Enum](xreflect.Convert[uint64](n)), enum)
 	}
 
 	// Map enum to all floats.
-	mtmap.Set(mtkey.Enum2Repr[Enum, float32](enum), any(xreflect.Convert[float32](n)))
-	mtmap.Set(mtkey.Enum2Repr[Enum, float64](enum), any(xreflect.Convert[float64](n)))
+	stageSet(s, mtkey.Enum2Repr[Enum, float32](enum), any(xreflect.Convert[float32](n)))
+	stageSet(s, mtkey.Enum2Repr[Enum, float64](enum), any(xreflect.Convert[float64](n)))
 
 	// Map all floats to enum.
-	mtmap.Set(mtkey.Repr2Enum[Enum](xreflect.Convert[float32](n)), enum)
-	mtmap.Set(mtkey.Repr2Enum[Enum](xreflect.Convert[float64](n)), enum)
+	stageSet(s, mtkey.Repr2Enum[Enum](xreflect.Convert[float32](n)), enum)
+	stageSet(s, mtkey.Repr2Enum[Enum](xreflect.Convert[float64](n)), enum)
 }
@@ -0,0 +1,39 @@
+package core
+
+// Conflict describes why a value cannot be mapped.
+type Conflict struct {
+	// Value is the value which cannot be mapped.
+	Value any
+
+	// Existing is the mapped value involved in the conflict, or nil if the
+	// conflict doesn't involve any other value.
+	Existing any
+
+	// Reason is the message which the mapping would panic with.
+	Reason string
+}
+
+// DryRunMapAny validates the mappings of the enum values to their
+// representations, in order, as if they were mapped by MapAny. It returns all
+// conflicts without writing into the registry.
+func DryRunMapAny[Enum any](values []Enum, reprs [][]any) []Conflict {
+	batch := newStage(nil)
+
+	var conflicts []Conflict
+	for i, value := range values {
+		s := newStage(batch)
+
+		var conflicted bool
+		stageMapAny(s, value, reprs[i], false, func(c Conflict) {
+			conflicts = append(conflicts, c)
+			conflicted = true
+		})
+
+		// Later values only conflict with the values which could be mapped.
+		if !conflicted {
+			s.commit()
+		}
+	}
+
+	return conflicts
+}
@@ -0,0 +1,35 @@
+package core
+
+import "sync"
+
+// internTable shares the backing storage of identical strings registered by
+// different enum types, e.g. country codes used by several types. It is only
+// used while registering values, so the lock is never taken by lookups.
+var internTable = struct {
+	sync.Mutex
+	strings    map[string]string
+	references int
+}{strings: make(map[string]string)}
+
+// intern returns the canonical copy of s.
+func intern(s string) string {
+	internTable.Lock()
+	defer internTable.Unlock()
+
+	internTable.references++
+	if canonical, ok := internTable.strings[s]; ok {
+		return canonical
+	}
+
+	internTable.strings[s] = s
+	return s
+}
+
+// InternStats returns the number of unique interned strings and the number of
+// times strings were interned.
+func InternStats() (uniqueStrings, totalReferences int) {
+	internTable.Lock()
+	defer internTable.Unlock()
+
+	return len(internTable.strings), internTable.references
+}
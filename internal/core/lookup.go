@@ -0,0 +1,39 @@
+package core
+
+import (
+	"sync/atomic"
+
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// stringMatcherInUse is true if any enum type set a string normalizer, so
+// other types skip its lookup.
+var stringMatcherInUse atomic.Bool
+
+// SetStringNormalizer sets the normalizer applied by LookupString to the
+// strings which are not an exact match. The caller maintains the index of the
+// normalized strings (see mtkey.Normalized2Enum).
+func SetStringNormalizer[Enum any](normalize func(string) string) {
+	registry.Set(mtkey.StringNormalizer[Enum](), normalize)
+	if normalize != nil {
+		stringMatcherInUse.Store(true)
+	}
+}
+
+// LookupString resolves the string representation or alias of a value,
+// applying the string normalizer of the enum type (see SetStringMatcher and
+// SetParseOptions) if the string is not an exact match.
+func LookupString[Enum any](s string) (Enum, bool) {
+	enum, ok := registry.Get2(mtkey.Repr2Enum[Enum](s))
+	if ok || !stringMatcherInUse.Load() {
+		return enum, ok
+	}
+
+	normalize := registry.Get(mtkey.StringNormalizer[Enum]())
+	if normalize == nil {
+		return enum, false
+	}
+
+	return registry.Get2(mtkey.Normalized2Enum[Enum](normalize(s)))
+}
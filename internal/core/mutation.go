@@ -0,0 +1,48 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// Mutation classifies the registry writes of public APIs.
+type Mutation int
+
+const (
+	// ValueMutation changes the set of values or how they are resolved from
+	// their representations. It is blocked once the enum is finalized.
+	ValueMutation Mutation = iota
+
+	// MetadataMutation only adds information about existing values or
+	// configures optional behaviors. It is blocked once the enum is frozen,
+	// but allowed after it is finalized.
+	MetadataMutation
+)
+
+// CheckMutable panics if the mutation op is not allowed for the enum type in
+// its current state. All public mutators must call it before writing into the
+// registry.
+func CheckMutable[Enum any](kind Mutation, op string) {
+	if reason := mutationConflict[Enum](kind, op); reason != "" {
+		panic(reason)
+	}
+}
+
+// mutationConflict returns the reason why the mutation op is not allowed for
+// the enum type in its current state, or an empty string if it is allowed.
+func mutationConflict[Enum any](kind Mutation, op string) string {
+	switch kind {
+	case ValueMutation:
+		if registry.Get(mtkey.IsFinalized[Enum]()) {
+			return fmt.Sprintf("enum %s: the enum was already finalized", TrueNameOf[Enum]())
+		}
+	case MetadataMutation:
+		if registry.Get(mtkey.IsFrozen[Enum]()) {
+			return fmt.Sprintf("enum %s: cannot %s, the enum was already frozen", TrueNameOf[Enum](), op)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// Numbering styles of the values of an enum type.
+const (
+	numberedAuto uint8 = 1 << iota
+	numberedExplicit
+)
+
+func numberingStyle(autoNumbered bool) uint8 {
+	if autoNumbered {
+		return numberedAuto
+	}
+
+	return numberedExplicit
+}
+
+// numberingStyleAllowed returns false if the enum type requires a single
+// numbering style and a value with the given style would mix both styles.
+func numberingStyleAllowed[Enum any](s *stage, autoNumbered bool) bool {
+	if !stageGet(s, mtkey.SingleNumberingStyle[Enum]()) {
+		return true
+	}
+
+	styles := stageGet(s, mtkey.NumberingStyles[Enum]())
+	return styles == 0 || styles == numberingStyle(autoNumbered)
+}
+
+// stageNumberingStyle records the numbering style of a mapped value.
+func stageNumberingStyle[Enum any](s *stage, enum Enum, autoNumbered bool) {
+	styles := stageGet(s, mtkey.NumberingStyles[Enum]())
+	stageSet(s, mtkey.NumberingStyles[Enum](), styles|numberingStyle(autoNumbered))
+
+	if autoNumbered {
+		stageSet(s, mtkey.AutoNumbered(enum), true)
+	}
+}
+
+// RequireSingleNumberingStyle forbids mixing auto-assigned and explicit
+// numbers in the enum type. It panics if they were already mixed.
+func RequireSingleNumberingStyle[Enum any]() {
+	if registry.Get(mtkey.NumberingStyles[Enum]()) == numberedAuto|numberedExplicit {
+		panic(fmt.Sprintf("enum %s: auto-assigned and explicit numbers were already mixed", TrueNameOf[Enum]()))
+	}
+
+	registry.Set(mtkey.SingleNumberingStyle[Enum](), true)
+}
+
+// numberConflictMessage returns the reason why the number cannot be mapped to
+// the enum value, because it was already mapped to another value.
+func numberConflictMessage[Enum any](s *stage, n any, occupant Enum) string {
+	if !stageGet(s, mtkey.AutoNumbered(occupant)) {
+		return fmt.Sprintf("number %v was already mapped to %v", n, occupant)
+	}
+
+	name, _ := stageGet2(s, mtkey.Enum2Repr[Enum, string](occupant))
+	return fmt.Sprintf("number %s was auto-assigned to %v by enum.New; "+
+		"pass an explicit number or reorder registrations", formatNumber(n), name)
+}
+
+// formatNumber formats a number without calling its String method, e.g. of a
+// WrapEnum.
+func formatNumber(n any) string {
+	v := reflect.ValueOf(n)
+	switch {
+	case v.CanInt():
+		return strconv.FormatInt(v.Int(), 10)
+	case v.CanUint():
+		return strconv.FormatUint(v.Uint(), 10)
+	case v.CanFloat():
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	default:
+		return fmt.Sprint(n)
+	}
+}
@@ -0,0 +1,219 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/xybor-x/enum/internal/xreflect"
+)
+
+type optionKind int
+
+const (
+	strOption optionKind = iota + 1
+	numOption
+	reprOption
+	aliasOption
+)
+
+// Option is a representation of an enum value whose meaning is given by its
+// constructor instead of its type.
+type Option struct {
+	kind  optionKind
+	value any
+}
+
+func StrOption(s string) Option {
+	return Option{kind: strOption, value: s}
+}
+
+func NumOption(n any) Option {
+	return Option{kind: numOption, value: n}
+}
+
+func ReprOption(v any) Option {
+	return Option{kind: reprOption, value: v}
+}
+
+func AliasOption(s string) Option {
+	return Option{kind: aliasOption, value: s}
+}
+
+// Spec is the classified representations of an enum value, besides the enum
+// value itself.
+type Spec struct {
+	// Str is the string representation, if HasStr.
+	Str    string
+	HasStr bool
+
+	// Num is the primitive numeric representation, nil if none.
+	Num any
+
+	// Reprs are the custom representations.
+	Reprs []any
+
+	// Aliases are additional strings resolved to the enum value.
+	Aliases []string
+
+	// Guess derives the missing string representation from a Stringer or
+	// string-kinded custom representation, and the missing numeric
+	// representation from a number-kinded one, as the legacy arguments of Map.
+	Guess bool
+}
+
+// HasOptions reports whether the representations are options.
+func HasOptions(reprs []any) bool {
+	for _, repr := range reprs {
+		if _, ok := repr.(Option); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isOption(repr any, kind optionKind) bool {
+	opt, ok := repr.(Option)
+	return ok && opt.kind == kind
+}
+
+// CheckOptions panics if options are mixed with other representations, before
+// New removes the option of the enum value.
+func CheckOptions[Enum any](reprs []any) {
+	if !HasOptions(reprs) {
+		return
+	}
+
+	for _, repr := range reprs {
+		if _, ok := repr.(Option); !ok {
+			panic(fmt.Sprintf("enum %s: cannot mix options with other representations (%v)", TrueNameOf[Enum](), repr))
+		}
+	}
+}
+
+// parseReprs classifies the arguments of Map, either options or legacy
+// representations.
+func parseReprs[Enum any](enum Enum, reprs []any, conflict func(existing any, format string, args ...any)) Spec {
+	if HasOptions(reprs) {
+		return optionSpec(enum, reprs, conflict)
+	}
+
+	return legacySpec(enum, reprs, conflict)
+}
+
+func optionSpec[Enum any](enum Enum, reprs []any, conflict func(existing any, format string, args ...any)) Spec {
+	var spec Spec
+
+	for _, repr := range reprs {
+		opt, ok := repr.(Option)
+		if !ok {
+			conflict(nil, "enum %s (%#v): cannot mix options with other representations (%v)",
+				TrueNameOf[Enum](), enum, repr)
+			continue
+		}
+
+		switch opt.kind {
+		case strOption:
+			if spec.HasStr {
+				conflict(nil, "enum %s (%#v): multiple strings are provided (%v, %v)",
+					TrueNameOf[Enum](), enum, spec.Str, opt.value)
+				continue
+			}
+
+			spec.Str = opt.value.(string)
+			spec.HasStr = true
+
+		case numOption:
+			if spec.Num != nil {
+				conflict(nil, "enum %s (%#v): multiple numbers are provided (%v, %v)",
+					TrueNameOf[Enum](), enum, spec.Num, opt.value)
+				continue
+			}
+
+			spec.Num = opt.value
+
+		case reprOption:
+			if xreflect.IsPrimitiveString(opt.value) || xreflect.IsPrimitiveNumber(opt.value) {
+				conflict(nil, "enum %s (%#v): custom representation %v cannot be a primitive string or number",
+					TrueNameOf[Enum](), enum, opt.value)
+				continue
+			}
+
+			spec.Reprs = append(spec.Reprs, opt.value)
+
+		case aliasOption:
+			spec.Aliases = append(spec.Aliases, opt.value.(string))
+		}
+	}
+
+	return spec
+}
+
+func legacySpec[Enum any](enum Enum, reprs []any, conflict func(existing any, format string, args ...any)) Spec {
+	spec := Spec{Guess: true}
+
+	var hasPrimitiveStr bool
+	for _, repr := range reprs {
+		switch {
+		case xreflect.IsPrimitiveNumber(repr):
+			if spec.Num != nil {
+				conflict(nil, "enum %s (%#v): multiple primitive numerics are provided (%v, %v)",
+					TrueNameOf[Enum](), enum, spec.Num, repr)
+				continue
+			}
+
+			spec.Num = repr
+
+		case xreflect.IsPrimitiveString(repr):
+			if hasPrimitiveStr {
+				conflict(nil, "enum %s (%#v): multiple primitive strings are provided (%v, %v)",
+					TrueNameOf[Enum](), enum, spec.Str, repr)
+				continue
+			}
+
+			spec.Str = xreflect.Convert[string](repr)
+			spec.HasStr = true
+			hasPrimitiveStr = true
+
+		default:
+			spec.Reprs = append(spec.Reprs, repr)
+		}
+	}
+
+	return spec
+}
+
+// StrRepr returns the string representation, guessed from the custom
+// representations if allowed.
+func (s Spec) StrRepr() (string, bool) {
+	if s.HasStr || !s.Guess {
+		return s.Str, s.HasStr
+	}
+
+	for _, repr := range s.Reprs {
+		if xreflect.IsImplement[fmt.Stringer](repr) {
+			return repr.(fmt.Stringer).String(), true
+		}
+
+		if xreflect.IsString(repr) {
+			return xreflect.Convert[string](repr), true
+		}
+	}
+
+	return "", false
+}
+
+// NumRepr returns the numeric representation, guessed from the custom
+// representations if allowed, nil if none.
+func (s Spec) NumRepr() any {
+	if s.Num != nil || !s.Guess {
+		return s.Num
+	}
+
+	for _, repr := range s.Reprs {
+		if xreflect.IsNumber(repr) {
+			return repr
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+const modulePath = "github.com/xybor-x/enum"
+
+// recordRegisteringPackage records the package which is registering a value of
+// the enum type. The package is the first caller outside of this library.
+func recordRegisteringPackage[Enum any]() {
+	pkg := callerPackage()
+	if pkg == "" {
+		return
+	}
+
+	pkgs := registry.Get(mtkey.RegisteredFrom[Enum]())
+	for _, p := range pkgs {
+		if p == pkg {
+			return
+		}
+	}
+
+	registry.Set(mtkey.RegisteredFrom[Enum](), append(pkgs, pkg))
+}
+
+// conflictPackages returns the packages which registered values of the enum
+// type, formatted to be appended to a conflict panic. It returns an empty
+// string if all values come from the same package.
+//
+// Advanced enums (e.g. WrapEnum[role]) declared in different packages with the
+// same underlying type are the identical Go type, so their values collide.
+func conflictPackages[Enum any]() string {
+	pkgs := registry.Get(mtkey.RegisteredFrom[Enum]())
+	if len(pkgs) < 2 {
+		return ""
+	}
+
+	return fmt.Sprintf(" (registered from packages %s)", strings.Join(pkgs, ", "))
+}
+
+func callerPackage() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+
+	for {
+		frame, more := frames.Next()
+		if pkg := packageOf(frame.Function); pkg != "" && !isLibraryPackage(pkg) {
+			return pkg
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
+
+// packageOf extracts the package path from a fully qualified function name,
+// e.g. "path/to/pkg.Func[...].func1" => "path/to/pkg".
+func packageOf(funcName string) string {
+	slash := strings.LastIndex(funcName, "/")
+	dot := strings.Index(funcName[slash+1:], ".")
+	if dot < 0 {
+		return ""
+	}
+
+	return funcName[:slash+1+dot]
+}
+
+// helperPackages are the packages which register values on behalf of their
+// callers (see MarkHelperPackage), e.g. protoenum.
+var helperPackages sync.Map
+
+// MarkHelperPackage marks the package of its caller as a helper package: the
+// values registered through its functions are recorded as registered by the
+// caller of these functions, as for the functions of this library. Helper
+// packages call it in their init function.
+func MarkHelperPackage() {
+	pc, _, _, ok := runtime.Caller(1)
+	if !ok {
+		return
+	}
+
+	if pkg := packageOf(runtime.FuncForPC(pc).Name()); pkg != "" {
+		helperPackages.Store(pkg, true)
+	}
+}
+
+func isLibraryPackage(pkg string) bool {
+	if pkg == modulePath || strings.HasPrefix(pkg, modulePath+"/internal/") {
+		return true
+	}
+
+	_, ok := helperPackages.Load(pkg)
+	return ok
+}
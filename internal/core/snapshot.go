@@ -0,0 +1,60 @@
+package core
+
+import (
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// TypeSnapshot is the registered values and the serialization settings of an
+// enum type.
+type TypeSnapshot struct {
+	Values []ValueSnapshot
+
+	PairFormat          bool
+	AcceptLegacyNumbers bool
+	JSONNumberFormat    bool
+	SQLNumberFormat     bool
+}
+
+// ValueSnapshot is the representations of a registered value.
+type ValueSnapshot struct {
+	String string
+	Number float64
+
+	// Pair is the composite numeric identity, nil if not mapped.
+	Pair *[2]int
+
+	// Desc is the description (see MapDesc), empty if none.
+	Desc string
+
+	// Aliases are the additional strings resolved to the value.
+	Aliases []string
+}
+
+func snapshotOf[Enum any]() TypeSnapshot {
+	snapshot := TypeSnapshot{
+		PairFormat:          registry.Get(mtkey.PairFormat[Enum]()),
+		AcceptLegacyNumbers: registry.Get(mtkey.AcceptLegacyNumbers[Enum]()),
+		JSONNumberFormat:    registry.Get(mtkey.JSONNumberFormat[Enum]()),
+		SQLNumberFormat:     registry.Get(mtkey.SQLNumberFormat[Enum]()),
+	}
+
+	for _, enum := range registry.Get(mtkey.AllEnums[Enum]()) {
+		str, _ := registry.Get(mtkey.Enum2Repr[Enum, string](enum)).(string)
+		number, _ := registry.Get(mtkey.Enum2Repr[Enum, float64](enum)).(float64)
+
+		value := ValueSnapshot{
+			String:  str,
+			Number:  number,
+			Desc:    registry.Get(mtkey.Enum2Desc(enum)),
+			Aliases: registry.Get(mtkey.Enum2Aliases(enum)),
+		}
+		if pair, ok := registry.Get2(mtkey.Enum2Pair(enum)); ok {
+			value.Pair = &pair
+		}
+
+		snapshot.Values = append(snapshot.Values, value)
+	}
+
+	return snapshot
+}
@@ -0,0 +1,56 @@
+package core
+
+import "github.com/xybor-x/enum/registry"
+
+// stage buffers registry writes, so mappings can be validated against the
+// registry together with the writes of previous mappings without modifying
+// the registry. A nil stage reads and writes the registry directly.
+type stage struct {
+	parent *stage
+	writes map[any]any
+}
+
+func newStage(parent *stage) *stage {
+	return &stage{parent: parent, writes: make(map[any]any)}
+}
+
+func stageGet2[V any](s *stage, key registry.Keyer[V]) (V, bool) {
+	for ; s != nil; s = s.parent {
+		if v, ok := s.writes[key]; ok {
+			return v.(V), true
+		}
+	}
+
+	return registry.Get2(key)
+}
+
+func stageGet[V any](s *stage, key registry.Keyer[V]) V {
+	v, _ := stageGet2(s, key)
+	return v
+}
+
+func stageSet[V any](s *stage, key registry.Keyer[V], v V) {
+	if s == nil {
+		registry.Set(key, v)
+		return
+	}
+
+	s.writes[key] = v
+}
+
+// intern returns the canonical copy of str, without touching the intern table
+// if the writes are buffered.
+func (s *stage) intern(str string) string {
+	if s != nil {
+		return str
+	}
+
+	return intern(str)
+}
+
+// commit merges the writes into the parent stage.
+func (s *stage) commit() {
+	for key, v := range s.writes {
+		s.parent.writes[key] = v
+	}
+}
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"reflect"
+
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// TypeInfo provides access to a registered enum type without knowing it at
+// compile time, e.g. when walking values by reflection.
+type TypeInfo struct {
+	Type     reflect.Type
+	Name     string
+	TrueName string
+
+	// IsValid reports whether the value, which must be of the enum type, is a
+	// registered value.
+	IsValid func(value any) bool
+
+	// Values returns the registered values of the enum type, in their
+	// registration order.
+	Values func() []any
+
+	// String returns the string representation of the value, which must be of
+	// the enum type, and whether it is valid.
+	String func(value any) (string, bool)
+
+	// FromString returns the value whose string representation or alias is s,
+	// matched as by the FromString function (see LookupString), and whether
+	// it is found.
+	FromString func(s string) (any, bool)
+
+	// Snapshot returns the registered values and the serialization settings
+	// of the enum type.
+	Snapshot func() TypeSnapshot
+}
+
+type typeInfoKey struct{ typ reflect.Type }
+
+func (typeInfoKey) InferValue() *TypeInfo { panic("not implemented") }
+
+type allTypesKey struct{}
+
+func (allTypesKey) InferValue() []*TypeInfo { panic("not implemented") }
+
+// registerType adds the enum type to the type index if it is not there yet.
+func registerType[Enum any]() {
+	typ := reflect.TypeOf((*Enum)(nil)).Elem()
+	if _, ok := registry.Get2(typeInfoKey{typ}); ok {
+		return
+	}
+
+	info := &TypeInfo{
+		Type:     typ,
+		Name:     NameOf[Enum](),
+		TrueName: TrueNameOf[Enum](),
+		IsValid: func(value any) bool {
+			enum, ok := value.(Enum)
+			if !ok {
+				return false
+			}
+
+			_, ok = registry.Get2(mtkey.Enum2Repr[Enum, string](enum))
+			return ok
+		},
+		Values: func() []any {
+			enums := registry.Get(mtkey.AllEnums[Enum]())
+			values := make([]any, len(enums))
+			for i, enum := range enums {
+				values[i] = enum
+			}
+
+			return values
+		},
+		String: func(value any) (string, bool) {
+			enum, ok := value.(Enum)
+			if !ok {
+				return "", false
+			}
+
+			str, ok := registry.Get(mtkey.Enum2Repr[Enum, string](enum)).(string)
+			return str, ok
+		},
+		FromString: func(s string) (any, bool) {
+			enum, ok := LookupString[Enum](s)
+			if !ok {
+				return nil, false
+			}
+
+			return enum, true
+		},
+		Snapshot: snapshotOf[Enum],
+	}
+
+	registry.Set(typeInfoKey{typ}, info)
+	registry.Set(allTypesKey{}, append(registry.Get(allTypesKey{}), info))
+}
+
+// LookupType returns the information of the registered enum type.
+func LookupType(typ reflect.Type) (*TypeInfo, bool) {
+	return registry.Get2(typeInfoKey{typ})
+}
+
+// AllTypes returns the information of all registered enum types, in order of
+// their first registration.
+func AllTypes() []*TypeInfo {
+	return registry.Get(allTypesKey{})
+}
@@ -0,0 +1,133 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Walker walks a value by reflection and reports every value of a registered
+// enum type, together with its path (e.g. "payload.items[17].status").
+//
+// Struct fields are named by their json tags if any, embedded structs without
+// tags are flattened like encoding/json does, and unexported fields are
+// skipped. Pointers, interfaces, slices, arrays, and map values are followed.
+type Walker struct {
+	// Visit is called for every value of a registered enum type.
+	Visit func(path string, value reflect.Value, info *TypeInfo)
+
+	// Unwrap, if set, is called before walking any struct value which is not
+	// a registered enum. If the value is a wrapper (e.g. a nullable enum), it
+	// returns true and the inner value to walk at the same path instead, which
+	// may be the zero reflect.Value to skip it.
+	Unwrap func(value reflect.Value) (reflect.Value, bool)
+}
+
+// Walk walks the value from the given root path.
+func (w Walker) Walk(path string, value reflect.Value) {
+	w.walk(path, value, map[uintptr]bool{})
+}
+
+func (w Walker) walk(path string, value reflect.Value, visited map[uintptr]bool) {
+	if !value.IsValid() {
+		return
+	}
+
+	if info, ok := LookupType(value.Type()); ok {
+		w.Visit(path, value, info)
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() || visited[value.Pointer()] {
+			return
+		}
+
+		visited[value.Pointer()] = true
+		w.walk(path, value.Elem(), visited)
+
+	case reflect.Interface:
+		w.walk(path, value.Elem(), visited)
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			w.walk(fmt.Sprintf("%s[%d]", path, i), value.Index(i), visited)
+		}
+
+	case reflect.Map:
+		// Sort the keys to walk in a deterministic order.
+		type entry struct {
+			name  string
+			value reflect.Value
+		}
+
+		entries := make([]entry, 0, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			entries = append(entries, entry{fmt.Sprint(iter.Key().Interface()), iter.Value()})
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].name < entries[j].name })
+
+		for _, e := range entries {
+			w.walk(fmt.Sprintf("%s[%s]", path, e.name), e.value, visited)
+		}
+
+	case reflect.Struct:
+		if w.Unwrap != nil {
+			if inner, ok := w.Unwrap(value); ok {
+				w.walk(path, inner, visited)
+				return
+			}
+		}
+
+		typ := value.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			name, tagged := fieldName(field)
+			if name == "-" {
+				continue
+			}
+
+			// Flatten embedded structs, but still report embedded enums under
+			// their own names.
+			if _, ok := LookupType(field.Type); field.Anonymous && !tagged && !ok {
+				w.walk(path, value.Field(i), visited)
+				continue
+			}
+
+			w.walk(joinPath(path, name), value.Field(i), visited)
+		}
+	}
+}
+
+func fieldName(field reflect.StructField) (string, bool) {
+	tag, ok := field.Tag.Lookup("json")
+	if !ok {
+		return field.Name, false
+	}
+
+	if tag == "-" {
+		return "-", true
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		return field.Name, false
+	}
+
+	return name, true
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -2,6 +2,7 @@ package mtkey
 
 import (
 	"reflect"
+	"sync/atomic"
 
 	"github.com/xybor-x/enum/internal/xreflect"
 )
@@ -14,6 +15,45 @@ func AllEnums[Enum any]() allEnums[Enum] {
 	return allEnums[Enum]{}
 }
 
+type allSortedEnums[Enum any] struct{}
+
+func (allSortedEnums[Enum]) InferValue() []Enum { panic("not implemented") }
+
+func AllSortedEnums[Enum any]() allSortedEnums[Enum] {
+	return allSortedEnums[Enum]{}
+}
+
+type stringsRevision[Enum any] struct{}
+
+func (stringsRevision[Enum]) InferValue() uint64 { panic("not implemented") }
+
+func StringsRevision[Enum any]() stringsRevision[Enum] {
+	return stringsRevision[Enum]{}
+}
+
+type foldIndex[Enum any] struct{}
+
+func (foldIndex[Enum]) InferValue() FoldIndexCache[Enum] { panic("not implemented") }
+
+func FoldIndex[Enum any]() foldIndex[Enum] {
+	return foldIndex[Enum]{}
+}
+
+// FoldIndexCache maps the case folded strings of an enum type to their values,
+// as of the revision of its strings.
+type FoldIndexCache[Enum any] struct {
+	Revision uint64
+	Index    map[string]Enum
+}
+
+type allStrings[Enum any] struct{}
+
+func (allStrings[Enum]) InferValue() []string { panic("not implemented") }
+
+func AllStrings[Enum any]() allStrings[Enum] {
+	return allStrings[Enum]{}
+}
+
 type isFinalized[Enum any] struct{}
 
 func (isFinalized[Enum]) InferValue() bool { panic("not implemented") }
@@ -68,3 +108,272 @@ func (repr2Enum[Enum]) InferValue() Enum { panic("not implemented") }
 func Repr2Enum[Enum any](key any) repr2Enum[Enum] {
 	return repr2Enum[Enum]{key: key}
 }
+
+type enumUsage[Enum any] struct{ key Enum }
+
+func (enumUsage[Enum]) InferValue() *atomic.Uint32 { panic("not implemented") }
+
+func EnumUsage[Enum any](key Enum) enumUsage[Enum] {
+	return enumUsage[Enum]{key: key}
+}
+
+type registeredFrom[Enum any] struct{}
+
+func (registeredFrom[Enum]) InferValue() []string { panic("not implemented") }
+
+func RegisteredFrom[Enum any]() registeredFrom[Enum] {
+	return registeredFrom[Enum]{}
+}
+
+type jsonbMapLenient[Enum any] struct{}
+
+func (jsonbMapLenient[Enum]) InferValue() bool { panic("not implemented") }
+
+func JSONBMapLenient[Enum any]() jsonbMapLenient[Enum] {
+	return jsonbMapLenient[Enum]{}
+}
+
+type finalizeHooks[Enum any] struct{}
+
+func (finalizeHooks[Enum]) InferValue() []func([]Enum) { panic("not implemented") }
+
+func FinalizeHooks[Enum any]() finalizeHooks[Enum] {
+	return finalizeHooks[Enum]{}
+}
+
+type anyFinalizeHooks struct{}
+
+func (anyFinalizeHooks) InferValue() []*func(string) { panic("not implemented") }
+
+func AnyFinalizeHooks() anyFinalizeHooks {
+	return anyFinalizeHooks{}
+}
+
+type enum2Desc[Enum any] struct{ key Enum }
+
+func (enum2Desc[Enum]) InferValue() string { panic("not implemented") }
+
+func Enum2Desc[Enum any](key Enum) enum2Desc[Enum] {
+	return enum2Desc[Enum]{key: key}
+}
+
+// ValueListingConfig configures how valid values are listed in errors.
+type ValueListingConfig struct {
+	Enabled          bool
+	WithDescriptions bool
+}
+
+type valueListing[Enum any] struct{}
+
+func (valueListing[Enum]) InferValue() ValueListingConfig { panic("not implemented") }
+
+func ValueListing[Enum any]() valueListing[Enum] {
+	return valueListing[Enum]{}
+}
+
+// InvalidMarshalingConfig configures how invalid values are serialized.
+type InvalidMarshalingConfig struct {
+	Enabled        bool
+	Placeholder    string
+	HasPlaceholder bool
+}
+
+type invalidMarshaling[Enum any] struct{}
+
+func (invalidMarshaling[Enum]) InferValue() InvalidMarshalingConfig { panic("not implemented") }
+
+func InvalidMarshaling[Enum any]() invalidMarshaling[Enum] {
+	return invalidMarshaling[Enum]{}
+}
+
+type jsonNullIsError[Enum any] struct{}
+
+func (jsonNullIsError[Enum]) InferValue() bool { panic("not implemented") }
+
+func JSONNullIsError[Enum any]() jsonNullIsError[Enum] {
+	return jsonNullIsError[Enum]{}
+}
+
+type jsonNumberFormat[Enum any] struct{}
+
+func (jsonNumberFormat[Enum]) InferValue() bool { panic("not implemented") }
+
+func JSONNumberFormat[Enum any]() jsonNumberFormat[Enum] {
+	return jsonNumberFormat[Enum]{}
+}
+
+type sqlNumberFormat[Enum any] struct{}
+
+func (sqlNumberFormat[Enum]) InferValue() bool { panic("not implemented") }
+
+func SQLNumberFormat[Enum any]() sqlNumberFormat[Enum] {
+	return sqlNumberFormat[Enum]{}
+}
+
+type gormDataType[Enum any] struct{}
+
+func (gormDataType[Enum]) InferValue() string { panic("not implemented") }
+
+func GormDataType[Enum any]() gormDataType[Enum] {
+	return gormDataType[Enum]{}
+}
+
+type acceptLegacyNumbers[Enum any] struct{}
+
+func (acceptLegacyNumbers[Enum]) InferValue() bool { panic("not implemented") }
+
+func AcceptLegacyNumbers[Enum any]() acceptLegacyNumbers[Enum] {
+	return acceptLegacyNumbers[Enum]{}
+}
+
+type subsetDeclared[S any] struct{}
+
+func (subsetDeclared[S]) InferValue() bool { panic("not implemented") }
+
+func SubsetDeclared[S any]() subsetDeclared[S] {
+	return subsetDeclared[S]{}
+}
+
+type subsetMember[S, Enum any] struct{ key Enum }
+
+func (subsetMember[S, Enum]) InferValue() bool { panic("not implemented") }
+
+func SubsetMember[S, Enum any](key Enum) subsetMember[S, Enum] {
+	return subsetMember[S, Enum]{key: key}
+}
+
+type subsetValues[S, Enum any] struct{}
+
+func (subsetValues[S, Enum]) InferValue() []Enum { panic("not implemented") }
+
+func SubsetValues[S, Enum any]() subsetValues[S, Enum] {
+	return subsetValues[S, Enum]{}
+}
+
+type isFrozen[Enum any] struct{}
+
+func (isFrozen[Enum]) InferValue() bool { panic("not implemented") }
+
+func IsFrozen[Enum any]() isFrozen[Enum] {
+	return isFrozen[Enum]{}
+}
+
+type transitionsDefined[Enum any] struct{}
+
+func (transitionsDefined[Enum]) InferValue() bool { panic("not implemented") }
+
+func TransitionsDefined[Enum any]() transitionsDefined[Enum] {
+	return transitionsDefined[Enum]{}
+}
+
+type transitionsFrom[Enum any] struct{ from Enum }
+
+func (transitionsFrom[Enum]) InferValue() []Enum { panic("not implemented") }
+
+func TransitionsFrom[Enum any](from Enum) transitionsFrom[Enum] {
+	return transitionsFrom[Enum]{from: from}
+}
+
+type transitionAllowed[Enum any] struct{ from, to Enum }
+
+func (transitionAllowed[Enum]) InferValue() bool { panic("not implemented") }
+
+func TransitionAllowed[Enum any](from, to Enum) transitionAllowed[Enum] {
+	return transitionAllowed[Enum]{from: from, to: to}
+}
+
+type expectations struct{}
+
+func (expectations) InferValue() []func() []error { panic("not implemented") }
+
+func Expectations() expectations {
+	return expectations{}
+}
+
+type pair2Enum[Enum any] struct{ major, minor int }
+
+func (pair2Enum[Enum]) InferValue() Enum { panic("not implemented") }
+
+func Pair2Enum[Enum any](major, minor int) pair2Enum[Enum] {
+	return pair2Enum[Enum]{major: major, minor: minor}
+}
+
+type enum2Pair[Enum any] struct{ key Enum }
+
+func (enum2Pair[Enum]) InferValue() [2]int { panic("not implemented") }
+
+func Enum2Pair[Enum any](key Enum) enum2Pair[Enum] {
+	return enum2Pair[Enum]{key: key}
+}
+
+type pairFormat[Enum any] struct{}
+
+func (pairFormat[Enum]) InferValue() bool { panic("not implemented") }
+
+func PairFormat[Enum any]() pairFormat[Enum] {
+	return pairFormat[Enum]{}
+}
+
+type autoNumbered[Enum any] struct{ key Enum }
+
+func (autoNumbered[Enum]) InferValue() bool { panic("not implemented") }
+
+func AutoNumbered[Enum any](key Enum) autoNumbered[Enum] {
+	return autoNumbered[Enum]{key: key}
+}
+
+type numberingStyles[Enum any] struct{}
+
+func (numberingStyles[Enum]) InferValue() uint8 { panic("not implemented") }
+
+func NumberingStyles[Enum any]() numberingStyles[Enum] {
+	return numberingStyles[Enum]{}
+}
+
+type singleNumberingStyle[Enum any] struct{}
+
+func (singleNumberingStyle[Enum]) InferValue() bool { panic("not implemented") }
+
+func SingleNumberingStyle[Enum any]() singleNumberingStyle[Enum] {
+	return singleNumberingStyle[Enum]{}
+}
+
+type stringNormalizer[Enum any] struct{}
+
+func (stringNormalizer[Enum]) InferValue() func(string) string { panic("not implemented") }
+
+func StringNormalizer[Enum any]() stringNormalizer[Enum] {
+	return stringNormalizer[Enum]{}
+}
+
+type matcherNormalizer[Enum any] struct{}
+
+func (matcherNormalizer[Enum]) InferValue() func(string) string { panic("not implemented") }
+
+func MatcherNormalizer[Enum any]() matcherNormalizer[Enum] {
+	return matcherNormalizer[Enum]{}
+}
+
+type parseNormalizer[Enum any] struct{}
+
+func (parseNormalizer[Enum]) InferValue() func(string) string { panic("not implemented") }
+
+func ParseNormalizer[Enum any]() parseNormalizer[Enum] {
+	return parseNormalizer[Enum]{}
+}
+
+type normalized2Enum[Enum any] struct{ key string }
+
+func (normalized2Enum[Enum]) InferValue() Enum { panic("not implemented") }
+
+func Normalized2Enum[Enum any](key string) normalized2Enum[Enum] {
+	return normalized2Enum[Enum]{key: key}
+}
+
+type enum2Aliases[Enum any] struct{ key Enum }
+
+func (enum2Aliases[Enum]) InferValue() []string { panic("not implemented") }
+
+func Enum2Aliases[Enum any](key Enum) enum2Aliases[Enum] {
+	return enum2Aliases[Enum]{key: key}
+}
@@ -0,0 +1,52 @@
+// Package xbson encodes and decodes the few BSON element values used by the
+// enum package, so it doesn't depend on the MongoDB driver.
+//
+// See https://bsonspec.org/spec.html.
+package xbson
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// BSON types of element values.
+const (
+	TypeString byte = 0x02
+	TypeInt32  byte = 0x10
+	TypeInt64  byte = 0x12
+)
+
+var errInvalid = errors.New("invalid bson value")
+
+// AppendString appends the BSON string value of s to dst.
+func AppendString(dst []byte, s string) []byte {
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(len(s)+1))
+	dst = append(dst, s...)
+	return append(dst, 0x00)
+}
+
+// ReadString decodes a BSON string value.
+func ReadString(data []byte) (string, error) {
+	if len(data) < 5 {
+		return "", errInvalid
+	}
+
+	n := int(binary.LittleEndian.Uint32(data))
+	if n < 1 || n != len(data)-4 || data[len(data)-1] != 0x00 {
+		return "", errInvalid
+	}
+
+	return string(data[4 : len(data)-1]), nil
+}
+
+// ReadInt decodes a BSON int32 or int64 value.
+func ReadInt(typ byte, data []byte) (int64, error) {
+	switch {
+	case typ == TypeInt32 && len(data) == 4:
+		return int64(int32(binary.LittleEndian.Uint32(data))), nil
+	case typ == TypeInt64 && len(data) == 8:
+		return int64(binary.LittleEndian.Uint64(data)), nil
+	default:
+		return 0, errInvalid
+	}
+}
@@ -0,0 +1,101 @@
+// Package xcbor encodes and decodes the few CBOR data items used by the enum
+// package, so it doesn't depend on a CBOR library.
+//
+// See RFC 8949.
+package xcbor
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// Major types of data items.
+const (
+	MajorUnsigned byte = 0
+	MajorNegative byte = 1
+	MajorText     byte = 3
+)
+
+var errInvalid = errors.New("invalid cbor data item")
+
+// AppendText appends the CBOR text string of s to dst.
+func AppendText(dst []byte, s string) []byte {
+	dst = appendHead(dst, MajorText, uint64(len(s)))
+	return append(dst, s...)
+}
+
+// Major returns the major type of the data item.
+func Major(data []byte) (byte, error) {
+	if len(data) == 0 {
+		return 0, errInvalid
+	}
+
+	return data[0] >> 5, nil
+}
+
+// ReadText decodes a definite-length CBOR text string.
+func ReadText(data []byte) (string, error) {
+	major, n, rest, err := readHead(data)
+	if err != nil || major != MajorText || uint64(len(rest)) != n {
+		return "", errInvalid
+	}
+
+	return string(rest), nil
+}
+
+// ReadInt decodes a CBOR unsigned or negative integer fitting in an int64.
+func ReadInt(data []byte) (int64, error) {
+	major, n, rest, err := readHead(data)
+	if err != nil || len(rest) != 0 || n > math.MaxInt64 {
+		return 0, errInvalid
+	}
+
+	switch major {
+	case MajorUnsigned:
+		return int64(n), nil
+	case MajorNegative:
+		return -1 - int64(n), nil
+	default:
+		return 0, errInvalid
+	}
+}
+
+func appendHead(dst []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(dst, major<<5|byte(n))
+	case n <= math.MaxUint8:
+		return append(dst, major<<5|24, byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(dst, major<<5|25), uint16(n))
+	case n <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(dst, major<<5|26), uint32(n))
+	default:
+		return binary.BigEndian.AppendUint64(append(dst, major<<5|27), n)
+	}
+}
+
+// readHead decodes the head of a data item, returning its major type, its
+// argument and the remaining data.
+func readHead(data []byte) (byte, uint64, []byte, error) {
+	if len(data) == 0 {
+		return 0, 0, nil, errInvalid
+	}
+
+	major, info, data := data[0]>>5, data[0]&0x1f, data[1:]
+	switch {
+	case info < 24:
+		return major, uint64(info), data, nil
+	case info == 24 && len(data) >= 1:
+		return major, uint64(data[0]), data[1:], nil
+	case info == 25 && len(data) >= 2:
+		return major, uint64(binary.BigEndian.Uint16(data)), data[2:], nil
+	case info == 26 && len(data) >= 4:
+		return major, uint64(binary.BigEndian.Uint32(data)), data[4:], nil
+	case info == 27 && len(data) >= 8:
+		return major, binary.BigEndian.Uint64(data), data[8:], nil
+	default:
+		return 0, 0, nil, errInvalid
+	}
+}
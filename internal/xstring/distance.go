@@ -0,0 +1,45 @@
+package xstring
+
+// Distance returns the edit distance between a and b, counting insertions,
+// deletions, substitutions and transpositions of adjacent bytes.
+func Distance(a, b string) int {
+	// d[i][j] is the distance between a[:i] and b[:j].
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(a)][len(b)]
+}
+
+// Closest returns the candidate closest to s within the max distance, and
+// false if there is none. Ties keep the first candidate.
+func Closest(s string, candidates []string, maxDistance int) (string, bool) {
+	best, bestDistance := "", maxDistance+1
+	for _, c := range candidates {
+		if d := Distance(s, c); d < bestDistance {
+			best, bestDistance = c, d
+		}
+	}
+
+	return best, bestDistance <= maxDistance
+}
@@ -0,0 +1,70 @@
+package xstring
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NFC composes the Latin letters followed by combining marks into their
+// precomposed forms, e.g. "é" into "é", as the Unicode normalization
+// form C does for these letters. Marks are composed in their order of
+// appearance, which is the canonical order for a single mark per letter and
+// for the common sequences of Vietnamese.
+func NFC(s string) string {
+	if !hasCombiningMark(s) {
+		return s
+	}
+
+	out := make([]rune, 0, len(s))
+	for _, r := range s {
+		if n := len(out); n > 0 {
+			if composed, ok := compositions[[2]rune{out[n-1], r}]; ok {
+				out[n-1] = composed
+				continue
+			}
+		}
+
+		out = append(out, r)
+	}
+
+	return string(out)
+}
+
+// hasCombiningMark returns true if s contains a combining diacritical mark.
+func hasCombiningMark(s string) bool {
+	for i := 0; i < len(s); i++ {
+		// U+0300 to U+036F are encoded as 0xCC 0x80 to 0xCD 0xAF.
+		if s[i] == 0xCC || s[i] == 0xCD {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Fold maps every character of s to a canonical character of its case
+// folding orbit, so strings which are equal under simple Unicode case folding
+// (see strings.EqualFold) are mapped to the same string.
+func Fold(s string) string {
+	return strings.Map(foldRune, s)
+}
+
+// foldRune returns the smallest character of the case folding orbit of r,
+// e.g. 'K' for 'k' and the Kelvin sign.
+func foldRune(r rune) rune {
+	if r < 0x80 {
+		if 'a' <= r && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+
+		return r
+	}
+
+	// The orbit is a cycle.
+	canonical := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		canonical = min(canonical, f)
+	}
+
+	return canonical
+}
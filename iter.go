@@ -0,0 +1,42 @@
+//go:build go1.23
+
+package enum
+
+import "iter"
+
+// Values returns an iterator over all enum values of a specific type, in the
+// same order as All. The iterator reads the registered values directly and
+// yields nothing if the enum type has no registered value.
+func Values[Enum any]() iter.Seq[Enum] {
+	return func(yield func(Enum) bool) {
+		for _, value := range AllRef[Enum]() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns an iterator over all enum values of a specific type
+// together with their string representations, in the same order as All.
+func Enumerate[Enum any]() iter.Seq2[Enum, string] {
+	return func(yield func(Enum, string) bool) {
+		for _, value := range AllRef[Enum]() {
+			if !yield(value, reprOf[string](value)) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSorted returns an iterator over all enum values of a specific type, in
+// the same order as AllSorted.
+func ValuesSorted[Enum any]() iter.Seq[Enum] {
+	return func(yield func(Enum) bool) {
+		for _, value := range sortedValues[Enum]() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
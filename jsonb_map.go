@@ -0,0 +1,115 @@
+package enum
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/xybor-x/enum/internal/core"
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// JSONBMap is a map keyed by enum values, serialized as a JSON object keyed by
+// their string representations. It can be stored in JSON (or JSONB) SQL
+// columns and used in API payloads.
+//
+// Both nil and empty maps are serialized as {}. Use Nullable[JSONBMap[Enum, V]]
+// to store NULL instead of an empty object.
+//
+// By default, an unknown key is rejected when deserializing. Use
+// SetJSONBMapLenient to skip unknown keys instead.
+type JSONBMap[Enum comparable, V any] map[Enum]V
+
+// SetJSONBMapLenient configures whether JSONBMap skips unknown keys of the enum
+// type instead of rejecting them when deserializing.
+func SetJSONBMapLenient[Enum any](lenient bool) {
+	core.CheckMutable[Enum](core.MetadataMutation, "configure JSONBMap")
+	registry.Set(mtkey.JSONBMapLenient[Enum](), lenient)
+}
+
+func (m JSONBMap[Enum, V]) MarshalJSON() ([]byte, error) {
+	strMap := make(map[string]V, len(m))
+	for k, v := range m {
+		s, ok := To[string](k)
+		if !ok {
+			return nil, fmt.Errorf("enum %s: invalid key %#v", TrueNameOf[Enum](), k)
+		}
+
+		strMap[s] = v
+	}
+
+	return json.Marshal(strMap)
+}
+
+func (m *JSONBMap[Enum, V]) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if token == nil {
+		*m = nil
+		return nil
+	}
+
+	if token != json.Delim('{') {
+		return fmt.Errorf("enum %s: JSONBMap requires a JSON object, got %s", TrueNameOf[Enum](), data)
+	}
+
+	lenient := registry.Get(mtkey.JSONBMapLenient[Enum]())
+	result := make(JSONBMap[Enum, V])
+	for i := 0; decoder.More(); i++ {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		key := token.(string)
+
+		var value V
+		if err := decoder.Decode(&value); err != nil {
+			return fmt.Errorf("enum %s: invalid value of key %s at index %d: %w", TrueNameOf[Enum](), key, i, err)
+		}
+
+		enum, ok := FromString[Enum](key)
+		if !ok {
+			if lenient {
+				continue
+			}
+
+			return fmt.Errorf("enum %s: unknown key %s at index %d", TrueNameOf[Enum](), key, i)
+		}
+
+		result[enum] = value
+	}
+
+	*m = result
+	return nil
+}
+
+// Value implements the driver.Valuer interface, it serializes the map into a
+// JSON object.
+func (m JSONBMap[Enum, V]) Value() (driver.Value, error) {
+	return m.MarshalJSON()
+}
+
+// Scan implements the sql.Scanner interface, it deserializes a JSON object
+// stored as string or []byte into the map.
+func (m *JSONBMap[Enum, V]) Scan(a any) error {
+	var data []byte
+	switch t := a.(type) {
+	case string:
+		data = []byte(t)
+	case []byte:
+		data = t
+	default:
+		return fmt.Errorf("enum %s: JSONBMap does not support type %v", TrueNameOf[Enum](), reflect.TypeOf(a))
+	}
+
+	return json.Unmarshal(data, m)
+}
@@ -0,0 +1,46 @@
+package enum
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// legacyIntSentinel is set by LegacyIntSentinel.
+var legacyIntSentinel atomic.Bool
+
+// LegacyIntSentinel configures whether the Int methods of WrapEnum and SafeEnum
+// return 0 for invalid enums, as they did before, instead of panicking.
+//
+// This is a migration aid for callers relying on the zero value: use IntE or
+// IntOr instead, then remove the call. Note that 0 may also be the int
+// representation of a valid value. The deprecated ToInt function keeps
+// returning math.MinInt32 for invalid enums.
+func LegacyIntSentinel(enabled bool) {
+	legacyIntSentinel.Store(enabled)
+}
+
+// intE returns the int representation of the enum, or an error if the enum is
+// invalid.
+func intE[Enum any](value Enum) (int, error) {
+	i, ok := To[int](value)
+	if !ok {
+		return 0, fmt.Errorf("enum %s: %w %#v", TrueNameOf[Enum](), ErrInvalidEnum, value)
+	}
+
+	return i, nil
+}
+
+// mustInt returns the int representation of the enum. It panics if the enum is
+// invalid, unless LegacyIntSentinel is enabled.
+func mustInt[Enum any](value Enum) int {
+	i, err := intE(value)
+	if err != nil {
+		if legacyIntSentinel.Load() {
+			return 0
+		}
+
+		panic(err.Error())
+	}
+
+	return i
+}
@@ -0,0 +1,107 @@
+package enum
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/xybor-x/enum/internal/core"
+)
+
+// MarkdownTable returns a Markdown table of the values of the enum type,
+// ordered by their numeric representations, e.g. to generate API docs:
+//
+//	| Number | String | Description |
+//	| -----: | ------ | ----------- |
+//	| 0 | user | A regular user. |
+//	| 1 | admin | Manages the users. |
+//
+// The Description and Aliases columns are only present if a value has a
+// description (see MapDesc) or aliases. Pipes in the cells are escaped.
+func MarkdownTable[Enum any]() string {
+	info, ok := core.LookupType(reflect.TypeOf((*Enum)(nil)).Elem())
+	if !ok {
+		return markdownTable(core.TypeSnapshot{})
+	}
+
+	return markdownTable(info.Snapshot())
+}
+
+// MarkdownTableAll returns the Markdown tables (see MarkdownTable) of all
+// registered enum types, in order of their registration, each under a heading
+// with the name of the type.
+func MarkdownTableAll() string {
+	var sections []string
+	for _, info := range core.AllTypes() {
+		sections = append(sections, "### "+escapeMarkdownCell(info.Name)+"\n\n"+markdownTable(info.Snapshot()))
+	}
+
+	return strings.Join(sections, "\n")
+}
+
+func markdownTable(snapshot core.TypeSnapshot) string {
+	values := slices.Clone(snapshot.Values)
+	slices.SortStableFunc(values, func(a, b core.ValueSnapshot) int {
+		switch {
+		case a.Number < b.Number:
+			return -1
+		case a.Number > b.Number:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	var hasDesc, hasAliases bool
+	for _, value := range values {
+		hasDesc = hasDesc || value.Desc != ""
+		hasAliases = hasAliases || len(value.Aliases) > 0
+	}
+
+	header := []string{"Number", "String"}
+	align := []string{"-----:", "------"}
+	if hasDesc {
+		header = append(header, "Description")
+		align = append(align, "-----------")
+	}
+
+	if hasAliases {
+		header = append(header, "Aliases")
+		align = append(align, "-------")
+	}
+
+	rows := [][]string{header, align}
+	for _, value := range values {
+		row := []string{formatNumber(value.Number), escapeMarkdownCell(value.String)}
+		if hasDesc {
+			row = append(row, escapeMarkdownCell(value.Desc))
+		}
+
+		if hasAliases {
+			aliases := make([]string, len(value.Aliases))
+			for i, alias := range value.Aliases {
+				aliases[i] = escapeMarkdownCell(alias)
+			}
+
+			row = append(row, strings.Join(aliases, ", "))
+		}
+
+		rows = append(rows, row)
+	}
+
+	var sb strings.Builder
+	for _, row := range rows {
+		sb.WriteString("| " + strings.Join(row, " | ") + " |\n")
+	}
+
+	return sb.String()
+}
+
+// escapeMarkdownCell escapes the pipes and line breaks which would break a
+// Markdown table cell.
+func escapeMarkdownCell(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", "<br>")
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
@@ -0,0 +1,281 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/xybor-x/enum/internal/core"
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/internal/xstring"
+	"github.com/xybor-x/enum/registry"
+)
+
+// StringMatcher is the strategy to match inputs with the string
+// representations of an enum type (see SetStringMatcher).
+type StringMatcher int
+
+const (
+	// MatchExact matches inputs byte by byte, the default.
+	MatchExact StringMatcher = iota
+
+	// MatchNFC matches inputs after composing Latin letters followed by
+	// combining marks, so decomposed inputs (NFD) match precomposed strings
+	// (NFC). Other scripts are matched exactly.
+	MatchNFC
+
+	// MatchFoldNFC is similar to MatchNFC, but also ignores the case, as
+	// strings.EqualFold.
+	MatchFoldNFC
+)
+
+func (m StringMatcher) normalizer() func(string) string {
+	switch m {
+	case MatchNFC:
+		return xstring.NFC
+	case MatchFoldNFC:
+		return func(s string) string { return xstring.Fold(xstring.NFC(s)) }
+	default:
+		return nil
+	}
+}
+
+// SetStringMatcher sets how inputs are matched with the string
+// representations of the enum type by FromString and all deserialization
+// functions. Exact matches are always looked up first, and serialization
+// always emits the registered strings.
+//
+//	enum.SetStringMatcher[Brand](enum.MatchNFC)
+//
+// It panics if two registered strings become equal under the matcher, as Map
+// does for the values registered afterwards. The matcher applies after the
+// options of SetParseOptions, if any.
+//
+// Note that this function is not thread-safe and should only be called during
+// initialization or other safe execution points to avoid race conditions.
+func SetStringMatcher[Enum any](matcher StringMatcher) {
+	core.CheckMutable[Enum](core.ValueMutation, "set the string matcher")
+
+	normalize := matcher.normalizer()
+	if matcher != MatchExact && normalize == nil {
+		panic(fmt.Sprintf("enum %s: unknown string matcher %d", TrueNameOf[Enum](), matcher))
+	}
+
+	setStringNormalizer[Enum](composeNormalizers(registry.Get(mtkey.ParseNormalizer[Enum]()), normalize))
+	registry.Set(mtkey.MatcherNormalizer[Enum](), normalize)
+}
+
+// ParseOption is an input normalization of SetParseOptions.
+type ParseOption func(*parseConfig)
+
+type parseConfig struct {
+	trimSpace       bool
+	caseInsensitive bool
+	replacements    map[rune]rune
+}
+
+// TrimSpace ignores leading and trailing white space, as strings.TrimSpace.
+func TrimSpace() ParseOption {
+	return func(c *parseConfig) {
+		c.trimSpace = true
+	}
+}
+
+// CaseInsensitive ignores the case, as strings.EqualFold.
+func CaseInsensitive() ParseOption {
+	return func(c *parseConfig) {
+		c.caseInsensitive = true
+	}
+}
+
+// ReplaceRunes replaces the keys of the map with their values, e.g. dashes
+// with underscores.
+func ReplaceRunes(replacements map[rune]rune) ParseOption {
+	return func(c *parseConfig) {
+		if c.replacements == nil {
+			c.replacements = make(map[rune]rune, len(replacements))
+		}
+
+		for from, to := range replacements {
+			c.replacements[from] = to
+		}
+	}
+}
+
+func (c parseConfig) normalizer() func(string) string {
+	if !c.trimSpace && !c.caseInsensitive && len(c.replacements) == 0 {
+		return nil
+	}
+
+	return func(s string) string {
+		if c.trimSpace {
+			s = strings.TrimSpace(s)
+		}
+
+		if len(c.replacements) > 0 {
+			s = strings.Map(func(r rune) rune {
+				if to, ok := c.replacements[r]; ok {
+					return to
+				}
+				return r
+			}, s)
+		}
+
+		if c.caseInsensitive {
+			s = xstring.Fold(s)
+		}
+
+		return s
+	}
+}
+
+// SetParseOptions sets how inputs are normalized before being matched with
+// the string representations of the enum type by FromString and all
+// deserialization functions, e.g. for feeds sending " Admin " for "admin":
+//
+//	enum.SetParseOptions[Role](enum.TrimSpace(), enum.CaseInsensitive())
+//
+// Without option, inputs must match exactly, the default. As SetStringMatcher,
+// exact matches are always looked up first, serialization always emits the
+// registered strings, and it panics if two registered strings become equal
+// under the options. The options replace those of the previous call, and apply
+// before the string matcher.
+//
+// Note that this function is not thread-safe and should only be called during
+// initialization or other safe execution points to avoid race conditions.
+func SetParseOptions[Enum any](opts ...ParseOption) {
+	core.CheckMutable[Enum](core.ValueMutation, "set the parse options")
+
+	var config parseConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	normalize := config.normalizer()
+	setStringNormalizer[Enum](composeNormalizers(normalize, registry.Get(mtkey.MatcherNormalizer[Enum]())))
+	registry.Set(mtkey.ParseNormalizer[Enum](), normalize)
+}
+
+// setStringNormalizer indexes the strings of the enum type under the
+// normalizer, which is nil for exact matches only.
+//
+// It panics if two registered strings become equal under the normalizer.
+func setStringNormalizer[Enum any](normalize func(string) string) {
+	values := AllRef[Enum]()
+
+	index := make(map[string]Enum, len(values))
+	if normalize != nil {
+		for _, value := range values {
+			for _, str := range stringsOf(value) {
+				key := normalize(str)
+				if other, ok := index[key]; ok {
+					panic(fmt.Sprintf("enum %s: strings %s and %s collide under the string matcher",
+						TrueNameOf[Enum](), normalizedOwner(other, key, normalize), str))
+				}
+
+				index[key] = value
+			}
+		}
+	}
+
+	if old := registry.Get(mtkey.StringNormalizer[Enum]()); old != nil {
+		for _, value := range values {
+			for _, str := range stringsOf(value) {
+				registry.Delete(mtkey.Normalized2Enum[Enum](old(str)))
+			}
+		}
+	}
+
+	for key, value := range index {
+		registry.Set(mtkey.Normalized2Enum[Enum](key), value)
+	}
+
+	core.SetStringNormalizer[Enum](normalize)
+}
+
+// FromStringFold is similar to FromString, but ignores the case of the string
+// and of the registered strings (including aliases), as strings.EqualFold.
+// Exact matches are looked up first.
+//
+// The case-insensitive index is built on first use, and rebuilt after new
+// strings are mapped. It panics if two registered strings differ only by
+// case.
+func FromStringFold[Enum any](s string) (Enum, bool) {
+	if enum, ok := FromString[Enum](s); ok {
+		return enum, true
+	}
+
+	enum, ok := foldIndex[Enum]()[xstring.Fold(s)]
+	if ok {
+		markUsage(enum, usageResolved)
+	}
+
+	return enum, ok
+}
+
+// MustFromStringFold is similar to FromStringFold, but returns the zero value
+// if the string does not correspond to a valid enum value.
+func MustFromStringFold[Enum any](s string) Enum {
+	enum, _ := FromStringFold[Enum](s)
+	return enum
+}
+
+// foldIndex returns the case-insensitive index of FromStringFold, building it
+// if the strings of the enum type changed since the last build.
+func foldIndex[Enum any]() map[string]Enum {
+	revision := registry.Get(mtkey.StringsRevision[Enum]())
+	if cache, ok := registry.Get2(mtkey.FoldIndex[Enum]()); ok && cache.Revision == revision {
+		return cache.Index
+	}
+
+	values := AllRef[Enum]()
+	index := make(map[string]Enum, len(values))
+	for _, value := range values {
+		for _, str := range stringsOf(value) {
+			key := xstring.Fold(str)
+			if other, ok := index[key]; ok && reprOf[string](other) != reprOf[string](value) {
+				panic(fmt.Sprintf("enum %s: strings %s and %s differ only by case",
+					TrueNameOf[Enum](), normalizedOwner(other, key, xstring.Fold), str))
+			}
+
+			index[key] = value
+		}
+	}
+
+	registry.Set(mtkey.FoldIndex[Enum](), mtkey.FoldIndexCache[Enum]{Revision: revision, Index: index})
+	return index
+}
+
+// composeNormalizers returns the normalizer applying first, then second. Nil
+// normalizers are skipped.
+func composeNormalizers(first, second func(string) string) func(string) string {
+	switch {
+	case first == nil:
+		return second
+	case second == nil:
+		return first
+	default:
+		return func(s string) string { return second(first(s)) }
+	}
+}
+
+// stringsOf returns the string representation of the value, then its aliases.
+func stringsOf[Enum any](value Enum) []string {
+	return append([]string{reprOf[string](value)}, registry.Get(mtkey.Enum2Aliases(value))...)
+}
+
+// normalizedOwner returns the string of the value which is normalized to key.
+func normalizedOwner[Enum any](value Enum, key string, normalize func(string) string) string {
+	for _, str := range stringsOf(value) {
+		if normalize(str) == key {
+			return str
+		}
+	}
+
+	return reprOf[string](value)
+}
+
+// lookupString resolves the string representation of a value, applying the
+// string matcher of the enum type if the string is not an exact match.
+func lookupString[Enum any](s string) (Enum, bool) {
+	return core.LookupString[Enum](s)
+}
@@ -0,0 +1,28 @@
+package enum
+
+import "fmt"
+
+// Must returns the value if ok is true, and panics otherwise. It unwraps the
+// (value, ok) results of FromString, From, To, etc. where a failure is a
+// programming error, e.g. during initialization or in tests:
+//
+//	var admin = enum.Must(enum.FromString[Role]("admin"))
+func Must[T any](value T, ok bool) T {
+	if !ok {
+		panic(fmt.Sprintf("enum %s: unexpected failure (got %#v)", TrueNameOf[T](), value))
+	}
+
+	return value
+}
+
+// MustOK is similar to Must, but unwraps the (value, error) results of
+// ParseString, ToSafe, etc. It panics with the error message if err is not nil.
+//
+//	var admin = enum.MustOK(enum.ToStringSafe(RoleAdmin))
+func MustOK[T any](value T, err error) T {
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return value
+}
@@ -1,22 +1,87 @@
 package enum
 
 import (
+	"database/sql"
 	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
 
-// Nullable allows handling nullable enums in JSON, YAML, and SQL.
+// Nullable allows handling nullable enums in JSON, YAML, XML, text, and SQL.
+//
+// If the Enum type implements the json, xml or sql interfaces itself (e.g.
+// JSONBMap), Nullable delegates to them for non-null values.
+//
+// As text (encoding.TextMarshaler), a null enum is the empty string. In XML, a
+// null enum is an empty element or attribute. An element with
+// xsi:nil="true" is also parsed as null.
 type Nullable[Enum any] struct {
 	Enum  Enum
 	Valid bool
 }
 
+// Null returns an invalid nullable enum.
+func Null[Enum any]() Nullable[Enum] {
+	return Nullable[Enum]{}
+}
+
+// Some returns a valid nullable enum holding the given value.
+func Some[Enum any](value Enum) Nullable[Enum] {
+	return Nullable[Enum]{Enum: value, Valid: true}
+}
+
+// NullableFromPtr returns an invalid nullable enum if ptr is nil, otherwise a
+// valid one holding the pointed value. The value itself is not validated.
+func NullableFromPtr[Enum any](ptr *Enum) Nullable[Enum] {
+	if ptr == nil {
+		return Nullable[Enum]{}
+	}
+
+	return Nullable[Enum]{Enum: *ptr, Valid: true}
+}
+
+// Ptr returns nil if the nullable enum is invalid, otherwise a pointer to a
+// copy of its value.
+func (e Nullable[Enum]) Ptr() *Enum {
+	if !e.Valid {
+		return nil
+	}
+
+	value := e.Enum
+	return &value
+}
+
+// Get returns the value of the nullable enum and whether it is valid.
+func (e Nullable[Enum]) Get() (Enum, bool) {
+	return e.Enum, e.Valid
+}
+
+// MustGet returns the value of the nullable enum. It panics if the nullable
+// enum is invalid.
+func (e Nullable[Enum]) MustGet() Enum {
+	if !e.Valid {
+		panic(fmt.Sprintf("enum %s: get the value of a null enum", TrueNameOf[Enum]()))
+	}
+
+	return e.Enum
+}
+
 func (e Nullable[Enum]) MarshalJSON() ([]byte, error) {
 	if !e.Valid {
 		return []byte("null"), nil
 	}
 
+	if marshaler, ok := any(e.Enum).(json.Marshaler); ok {
+		return marshaler.MarshalJSON()
+	}
+
 	return MarshalJSON(e.Enum)
 }
 
@@ -27,7 +92,19 @@ func (e *Nullable[Enum]) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	return UnmarshalJSON(data, &e.Enum)
+	var err error
+	if unmarshaler, ok := any(&e.Enum).(json.Unmarshaler); ok {
+		err = unmarshaler.UnmarshalJSON(data)
+	} else {
+		err = UnmarshalJSON(data, &e.Enum)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	e.Valid = true
+	return nil
 }
 
 func (e Nullable[Enum]) MarshalYAML() (any, error) {
@@ -48,14 +125,166 @@ func (e *Nullable[Enum]) UnmarshalYAML(node *yaml.Node) error {
 	return UnmarshalYAML(node, &e.Enum)
 }
 
+func (e Nullable[Enum]) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	if !e.Valid {
+		if start.Name.Local == "" {
+			start.Name.Local = NameOf[Enum]()
+		}
+
+		return encoder.EncodeElement("", start)
+	}
+
+	if marshaler, ok := any(e.Enum).(xml.Marshaler); ok {
+		return marshaler.MarshalXML(encoder, start)
+	}
+
+	return MarshalXML(encoder, start, e.Enum)
+}
+
+func (e *Nullable[Enum]) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := decoder.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+
+	if str == "" || isXMLNil(start) {
+		var defaultEnum Enum
+		e.Enum, e.Valid = defaultEnum, false
+		return nil
+	}
+
+	// The element has been consumed to detect null, so the Enum's own
+	// unmarshaler reads its content from a copy of the element.
+	decoder = xml.NewDecoder(strings.NewReader("<v>" + escapeXMLText(str) + "</v>"))
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+
+	var err error
+	if unmarshaler, ok := any(&e.Enum).(xml.Unmarshaler); ok {
+		err = unmarshaler.UnmarshalXML(decoder, start)
+	} else {
+		err = UnmarshalXML(decoder, start, &e.Enum)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	e.Valid = true
+	return nil
+}
+
+func (e Nullable[Enum]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !e.Valid {
+		return xml.Attr{Name: name}, nil
+	}
+
+	if marshaler, ok := any(e.Enum).(xml.MarshalerAttr); ok {
+		return marshaler.MarshalXMLAttr(name)
+	}
+
+	str, ok := To[string](e.Enum)
+	if !ok {
+		return xml.Attr{}, fmt.Errorf("enum %s: %w %#v", TrueNameOf[Enum](), ErrInvalidEnum, e.Enum)
+	}
+
+	return xml.Attr{Name: name, Value: str}, nil
+}
+
+func (e *Nullable[Enum]) UnmarshalXMLAttr(attr xml.Attr) error {
+	if attr.Value == "" {
+		var defaultEnum Enum
+		e.Enum, e.Valid = defaultEnum, false
+		return nil
+	}
+
+	if unmarshaler, ok := any(&e.Enum).(xml.UnmarshalerAttr); ok {
+		if err := unmarshaler.UnmarshalXMLAttr(attr); err != nil {
+			return err
+		}
+
+		e.Valid = true
+		return nil
+	}
+
+	val, ok := FromString[Enum](attr.Value)
+	if !ok {
+		return unknownStringError[Enum](attr.Value)
+	}
+
+	e.Enum, e.Valid = val, true
+	return nil
+}
+
+// escapeXMLText escapes s for use as the character data of an element.
+func escapeXMLText(s string) string {
+	var buf strings.Builder
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// isXMLNil reports whether the element is marked with xsi:nil="true".
+func isXMLNil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" && attr.Value == "true" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e Nullable[Enum]) MarshalText() ([]byte, error) {
+	if !e.Valid {
+		return []byte{}, nil
+	}
+
+	if marshaler, ok := any(e.Enum).(encoding.TextMarshaler); ok {
+		return marshaler.MarshalText()
+	}
+
+	return MarshalText(e.Enum)
+}
+
+func (e *Nullable[Enum]) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		var defaultEnum Enum
+		e.Enum, e.Valid = defaultEnum, false
+		return nil
+	}
+
+	var err error
+	if unmarshaler, ok := any(&e.Enum).(encoding.TextUnmarshaler); ok {
+		err = unmarshaler.UnmarshalText(data)
+	} else {
+		err = UnmarshalText(data, &e.Enum)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	e.Valid = true
+	return nil
+}
+
 func (e Nullable[Enum]) Value() (driver.Value, error) {
 	if !e.Valid {
 		return nil, nil
 	}
 
+	if valuer, ok := any(e.Enum).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
 	return ValueSQL(e.Enum)
 }
 
+// Scan implements sql.Scanner. NULL scans into an invalid nullable enum. Other
+// values are scanned by the Enum's own sql.Scanner if it has one, otherwise by
+// ScanSQL. Numbers which are not supported by either, e.g. with SQLString, are
+// resolved by the numeric representations.
 func (e *Nullable[Enum]) Scan(a any) error {
 	if a == nil {
 		var defaultEnum Enum
@@ -63,6 +292,113 @@ func (e *Nullable[Enum]) Scan(a any) error {
 		return nil
 	}
 
+	var enum Enum
+	var err error
+	if scanner, ok := any(&enum).(sql.Scanner); ok {
+		err = scanner.Scan(a)
+	} else {
+		err = ScanSQL(a, &enum)
+	}
+
+	if errors.Is(err, ErrUnsupportedScanType) {
+		switch t := a.(type) {
+		case int64, float64:
+			var ok bool
+			if enum, ok = From[Enum](t); !ok {
+				return unknownNumberError[Enum](t)
+			}
+
+			err = nil
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	e.Enum = enum
+
 	e.Valid = true
-	return ScanSQL(a, &e.Enum)
+	return nil
+}
+
+// GormDataType returns the column type of the enum for GORM migrations (see
+// SetGormDataType).
+func (e Nullable[Enum]) GormDataType() string {
+	if typer, ok := any(e.Enum).(interface{ GormDataType() string }); ok {
+		return typer.GormDataType()
+	}
+
+	return GormDataType[Enum]()
+}
+
+// IsZero reports whether the nullable enum is invalid. It allows the json
+// omitzero tag option (Go 1.24) to omit null enums.
+func (e Nullable[Enum]) IsZero() bool {
+	return !e.Valid
+}
+
+func (e Nullable[Enum]) nullable() (any, bool) {
+	return e.Enum, e.Valid
+}
+
+// Equal reports whether both nullable enums are valid and hold the same value,
+// or both are invalid. The Enum field of an invalid nullable enum is ignored.
+//
+// Values of types which are not comparable (e.g. SliceSerde or JSONBMap) are
+// compared with reflect.DeepEqual.
+func (e Nullable[Enum]) Equal(other Nullable[Enum]) bool {
+	if !e.Valid || !other.Valid {
+		return e.Valid == other.Valid
+	}
+
+	return equalValues(e.Enum, other.Enum)
+}
+
+// EqualValue reports whether the nullable enum is valid and holds the given
+// value, compared as in Equal.
+func (e Nullable[Enum]) EqualValue(value Enum) bool {
+	return e.Valid && equalValues(e.Enum, value)
+}
+
+// equalValues compares the values with ==, or with reflect.DeepEqual if their
+// type is not comparable.
+func equalValues[Enum any](a, b Enum) bool {
+	if !reflect.TypeOf((*Enum)(nil)).Elem().Comparable() {
+		return reflect.DeepEqual(a, b)
+	}
+
+	return any(a) == any(b)
+}
+
+// OrElse returns the value of the nullable enum if it is valid, otherwise
+// returns the given default value.
+func (e Nullable[Enum]) OrElse(def Enum) Enum {
+	if !e.Valid {
+		return def
+	}
+
+	return e.Enum
+}
+
+// Map applies f to the value of the nullable enum if it is valid. An invalid
+// nullable enum is returned as is, without calling f.
+func (e Nullable[Enum]) Map(f func(Enum) Enum) Nullable[Enum] {
+	if !e.Valid {
+		return Nullable[Enum]{}
+	}
+
+	return Nullable[Enum]{Enum: f(e.Enum), Valid: true}
+}
+
+// CoalesceNullable returns the first valid nullable enum. It returns an invalid
+// nullable enum if none of them is valid.
+func CoalesceNullable[Enum any](ns ...Nullable[Enum]) Nullable[Enum] {
+	for _, n := range ns {
+		if n.Valid {
+			return n
+		}
+	}
+
+	return Nullable[Enum]{}
 }
@@ -0,0 +1,58 @@
+package enum
+
+import (
+	"github.com/xybor-x/enum/internal/core"
+	"github.com/xybor-x/enum/internal/xreflect"
+)
+
+// Option is a representation of an enum value for MapOpts and NewOpts. Unlike
+// the arguments of Map and New, the meaning of an option is given by its
+// constructor, it is never guessed from its type.
+type Option = core.Option
+
+// Str is the string representation of the enum value.
+func Str(s string) Option {
+	return core.StrOption(s)
+}
+
+// Num is the numeric representation of the enum value.
+func Num[N xreflect.Number](n N) Option {
+	return core.NumOption(n)
+}
+
+// Repr is a custom representation of the enum value (e.g. a protobuf enum),
+// resolved by From and returned by To. It is never used as the string or
+// numeric representation, even if it implements fmt.Stringer.
+func Repr(v any) Option {
+	return core.ReprOption(v)
+}
+
+// Alias is an additional string resolved to the enum value by FromString and
+// all deserialization functions. It is never emitted.
+func Alias(s string) Option {
+	return core.AliasOption(s)
+}
+
+// MapOpts is similar to Map, but the representations are given as options:
+//
+//	RoleAdmin = enum.MapOpts(RoleAdmin, enum.Str("admin"), enum.Repr(proto.Role_Admin))
+//
+// Map and New also accept options, but panic if options are mixed with other
+// representations.
+func MapOpts[Enum any](enum Enum, opts ...Option) Enum {
+	return Map(enum, optionArgs(opts)...)
+}
+
+// NewOpts is similar to New, but the representations are given as options.
+func NewOpts[Enum any](opts ...Option) Enum {
+	return New[Enum](optionArgs(opts)...)
+}
+
+func optionArgs(opts []Option) []any {
+	args := make([]any, len(opts))
+	for i := range opts {
+		args[i] = opts[i]
+	}
+
+	return args
+}
@@ -0,0 +1,146 @@
+package enum
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/xybor-x/enum/internal/core"
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// CodePair is a composite numeric identity made of two numbers, e.g. the
+// category and the code of an error.
+type CodePair struct {
+	Major int
+	Minor int
+}
+
+// Pair returns the composite numeric identity (major, minor).
+func Pair(major, minor int) CodePair {
+	return CodePair{Major: major, Minor: minor}
+}
+
+// String returns the dotted form of the pair, e.g. "4.12".
+func (p CodePair) String() string {
+	return strconv.Itoa(p.Major) + "." + strconv.Itoa(p.Minor)
+}
+
+// pairFormatInUse is true if any enum type enabled the dotted pair format, so
+// other types skip its lookup.
+var pairFormatInUse atomic.Bool
+
+// MapPair maps an enum value to a composite numeric identity, then returns the
+// value.
+//
+//	var ErrQuotaExceeded = enum.MapPair(enum.New[Err]("quota_exceeded"), enum.Pair(4, 12))
+//
+// It panics if the value is invalid, if any number is negative, or if the
+// value or the pair was already mapped.
+//
+// Note that this function is not thread-safe and should only be called during
+// initialization or other safe execution points to avoid race conditions.
+func MapPair[Enum any](value Enum, pair CodePair) Enum {
+	core.CheckMutable[Enum](core.ValueMutation, "map a pair")
+
+	if !IsValid(value) {
+		panic(fmt.Sprintf("enum %s (%#v): cannot map a pair to an invalid value", TrueNameOf[Enum](), value))
+	}
+
+	if pair.Major < 0 || pair.Minor < 0 {
+		panic(fmt.Sprintf("enum %s (%#v): pair %s must not have negative numbers", TrueNameOf[Enum](), value, pair))
+	}
+
+	if v, ok := registry.Get2(mtkey.Pair2Enum[Enum](pair.Major, pair.Minor)); ok {
+		panic(fmt.Sprintf("enum %s (%#v): pair %s was already mapped to %v", TrueNameOf[Enum](), value, pair, v))
+	}
+
+	if _, ok := registry.Get2(mtkey.Enum2Pair(value)); ok {
+		panic(fmt.Sprintf("enum %s (%#v): do not map pair twice", TrueNameOf[Enum](), value))
+	}
+
+	registry.Set(mtkey.Pair2Enum[Enum](pair.Major, pair.Minor), value)
+	registry.Set(mtkey.Enum2Pair(value), [2]int{pair.Major, pair.Minor})
+	return value
+}
+
+// FromPair returns the corresponding enum for a given composite numeric
+// identity, and whether it is valid.
+func FromPair[Enum any](major, minor int) (Enum, bool) {
+	enum, ok := registry.Get2(mtkey.Pair2Enum[Enum](major, minor))
+	if ok {
+		markUsage(enum, usageResolved)
+	}
+
+	return enum, ok
+}
+
+// ToPair returns the composite numeric identity of an enum value, and whether
+// it has any.
+func ToPair[Enum any](value Enum) (int, int, bool) {
+	pair, ok := registry.Get2(mtkey.Enum2Pair(value))
+	return pair[0], pair[1], ok
+}
+
+// UsePairFormat configures whether the enum type is serialized in the dotted
+// form of its pairs (e.g. "4.12") instead of its string representation, by
+// MarshalJSON and ValueSQL. UnmarshalJSON and ScanSQL then accept both forms.
+func UsePairFormat[Enum any](enabled bool) {
+	core.CheckMutable[Enum](core.MetadataMutation, "configure the pair format")
+
+	registry.Set(mtkey.PairFormat[Enum](), enabled)
+	if enabled {
+		pairFormatInUse.Store(true)
+	}
+}
+
+func isPairFormat[Enum any]() bool {
+	return pairFormatInUse.Load() && registry.Get(mtkey.PairFormat[Enum]())
+}
+
+// pairString returns the dotted form of the pair of the enum value.
+func pairString[Enum any](value Enum) (string, error) {
+	major, minor, ok := ToPair(value)
+	if !ok {
+		return "", fmt.Errorf("enum %s: %w %#v", TrueNameOf[Enum](), ErrInvalidEnum, value)
+	}
+
+	markUsage(value, usageMarshaled)
+	return Pair(major, minor).String(), nil
+}
+
+// fromPairString resolves the dotted form of a pair. It returns nil error and
+// false if s is not in the dotted form.
+func fromPairString[Enum any](s string) (Enum, bool, error) {
+	var zero Enum
+
+	majorStr, minorStr, found := strings.Cut(s, ".")
+	if !found {
+		return zero, false, nil
+	}
+
+	major, ok1 := parsePairNumber(majorStr)
+	minor, ok2 := parsePairNumber(minorStr)
+	if !ok1 || !ok2 {
+		return zero, false, fmt.Errorf("enum %s: invalid pair %s", TrueNameOf[Enum](), s)
+	}
+
+	enum, ok := FromPair[Enum](major, minor)
+	if !ok {
+		return zero, false, fmt.Errorf("enum %s: unknown pair %s", TrueNameOf[Enum](), s)
+	}
+
+	return enum, true, nil
+}
+
+// parsePairNumber parses a non-negative decimal number without sign.
+func parsePairNumber(s string) (int, bool) {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
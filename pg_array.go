@@ -0,0 +1,186 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// PGArray is a slice of enum values, stored in PostgreSQL array columns (e.g.
+// text[] or an array of the enum type) as an array literal of their string
+// representations, e.g. {admin,user}.
+//
+// A nil slice is stored as NULL, and an empty slice as {}. NULL is scanned
+// into a nil slice.
+type PGArray[Enum any] []Enum
+
+// Enums returns the enum values of the array.
+func (a PGArray[Enum]) Enums() []Enum {
+	return []Enum(a)
+}
+
+// Value implements the driver.Valuer interface, it formats the string
+// representations of the enum values as an array literal. Elements are quoted
+// if needed, e.g. {"a,b","say \"hi\""}.
+func (a PGArray[Enum]) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	strs, err := ToStrings(a)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, s := range strs {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		writePGArrayElem(&b, s)
+	}
+	b.WriteByte('}')
+
+	return b.String(), nil
+}
+
+// Scan implements the sql.Scanner interface, it parses a one-dimensional array
+// literal stored as string or []byte into the array. Literals with a dimension
+// prefix (e.g. [1:2]={admin,user}) and multi-dimensional arrays are rejected.
+func (a *PGArray[Enum]) Scan(src any) error {
+	var data string
+	switch t := src.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case string:
+		data = t
+	case []byte:
+		data = string(t)
+	default:
+		return fmt.Errorf("enum %s: PGArray does not support type %v", TrueNameOf[Enum](), reflect.TypeOf(src))
+	}
+
+	elems, err := parsePGArray(data)
+	if err != nil {
+		return fmt.Errorf("enum %s: %w", TrueNameOf[Enum](), err)
+	}
+
+	values := make(PGArray[Enum], len(elems))
+	for i, elem := range elems {
+		if elem == nil {
+			return fmt.Errorf("enum %s: NULL element at index %d", TrueNameOf[Enum](), i)
+		}
+
+		value, ok := FromString[Enum](*elem)
+		if !ok {
+			return fmt.Errorf("enum %s: %w %s at index %d", TrueNameOf[Enum](), ErrUnknownString, *elem, i)
+		}
+
+		values[i] = value
+	}
+
+	*a = values
+	return nil
+}
+
+// writePGArrayElem writes the element of an array literal, quoted if it is
+// empty, NULL, or contains special characters.
+func writePGArrayElem(b *strings.Builder, s string) {
+	if s != "" && !strings.EqualFold(s, "NULL") && !strings.ContainsAny(s, "{}\",\\ \t\n\r\v\f") {
+		b.WriteString(s)
+		return
+	}
+
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+}
+
+// parsePGArray parses a one-dimensional array literal. Unquoted NULL elements
+// are returned as nil.
+func parsePGArray(s string) ([]*string, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("invalid array literal %s", s)
+	}
+
+	body := s[1 : len(s)-1]
+	elems := []*string{}
+	if strings.TrimSpace(body) == "" {
+		return elems, nil
+	}
+
+	for i := 0; ; {
+		for i < len(body) && isPGArraySpace(body[i]) {
+			i++
+		}
+
+		var elem strings.Builder
+		quoted := i < len(body) && body[i] == '"'
+		if quoted {
+			i++
+			for ; i < len(body) && body[i] != '"'; i++ {
+				if body[i] == '\\' {
+					i++
+				}
+				if i < len(body) {
+					elem.WriteByte(body[i])
+				}
+			}
+
+			if i >= len(body) {
+				return nil, errors.New("unterminated quoted element in array literal " + s)
+			}
+			i++
+
+			for i < len(body) && isPGArraySpace(body[i]) {
+				i++
+			}
+		} else {
+			for ; i < len(body) && body[i] != ','; i++ {
+				switch body[i] {
+				case '{', '}', '"':
+					return nil, fmt.Errorf("unexpected %c in array literal %s", body[i], s)
+				case '\\':
+					i++
+				}
+				if i < len(body) {
+					elem.WriteByte(body[i])
+				}
+			}
+		}
+
+		str := elem.String()
+		if !quoted {
+			str = strings.TrimRight(str, " \t\n\r\v\f")
+		}
+
+		if !quoted && strings.EqualFold(str, "NULL") {
+			elems = append(elems, nil)
+		} else {
+			elems = append(elems, &str)
+		}
+
+		if i >= len(body) {
+			return elems, nil
+		}
+
+		if body[i] != ',' {
+			return nil, fmt.Errorf("unexpected %c in array literal %s", body[i], s)
+		}
+		i++
+	}
+}
+
+func isPGArraySpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
@@ -0,0 +1,19 @@
+module github.com/xybor-x/enum/pgxenum
+
+go 1.21.1
+
+require (
+	github.com/jackc/pgx/v5 v5.5.5
+	github.com/stretchr/testify v1.10.0
+	github.com/xybor-x/enum v0.3.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/crypto v0.17.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
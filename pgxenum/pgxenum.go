@@ -0,0 +1,170 @@
+// Package pgxenum maps the enum types of github.com/xybor-x/enum to PostgreSQL
+// enum types (CREATE TYPE ... AS ENUM) in pgx v5, which bypasses
+// driver.Valuer and sql.Scanner in the binary protocol.
+//
+//	if err := pgxenum.RegisterEnumType[Role](ctx, conn, "role"); err != nil {
+//		return err
+//	}
+//
+// Values are encoded as their string representations, in both the text and the
+// binary formats. enum.Nullable values are encoded as NULL if invalid.
+package pgxenum
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xybor-x/enum"
+)
+
+// RegisterEnumType loads the PostgreSQL type typeName and registers Codec for
+// the enum type on the connection. Call it from the AfterConnect hook of a
+// pool, so all connections know the type.
+func RegisterEnumType[Enum any](ctx context.Context, conn *pgx.Conn, typeName string) error {
+	typ, err := conn.LoadType(ctx, typeName)
+	if err != nil {
+		return fmt.Errorf("enum %s: load type %s: %w", enum.TrueNameOf[Enum](), typeName, err)
+	}
+
+	conn.TypeMap().RegisterType(&pgtype.Type{Name: typ.Name, OID: typ.OID, Codec: Codec[Enum]{}})
+	return nil
+}
+
+// Codec is a pgtype.Codec which encodes and decodes the enum type (and
+// enum.Nullable of it) as the labels of a PostgreSQL enum type. Strings are
+// passed through, as by pgtype.EnumCodec.
+type Codec[Enum any] struct{}
+
+var _ pgtype.Codec = Codec[int]{}
+
+func (Codec[Enum]) FormatSupported(format int16) bool {
+	return format == pgtype.TextFormatCode || format == pgtype.BinaryFormatCode
+}
+
+func (Codec[Enum]) PreferredFormat() int16 {
+	return pgtype.TextFormatCode
+}
+
+func (Codec[Enum]) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if format != pgtype.TextFormatCode && format != pgtype.BinaryFormatCode {
+		return nil
+	}
+
+	switch value.(type) {
+	case Enum:
+		return encodePlanEnum[Enum]{}
+	case enum.Nullable[Enum]:
+		return encodePlanNullable[Enum]{}
+	case string:
+		return encodePlanString{}
+	}
+
+	return nil
+}
+
+func (Codec[Enum]) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if format != pgtype.TextFormatCode && format != pgtype.BinaryFormatCode {
+		return nil
+	}
+
+	switch target.(type) {
+	case *Enum:
+		return scanPlanEnum[Enum]{}
+	case *enum.Nullable[Enum]:
+		return scanPlanNullable[Enum]{}
+	case *string:
+		return scanPlanString{}
+	}
+
+	return nil
+}
+
+func (Codec[Enum]) DecodeDatabaseSQLValue(m *pgtype.Map, oid uint32, format int16, src []byte) (driver.Value, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	return string(src), nil
+}
+
+func (Codec[Enum]) DecodeValue(m *pgtype.Map, oid uint32, format int16, src []byte) (any, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	var value Enum
+	if err := enum.UnmarshalText(src, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+type encodePlanEnum[Enum any] struct{}
+
+func (encodePlanEnum[Enum]) Encode(value any, buf []byte) ([]byte, error) {
+	text, err := enum.MarshalText(value.(Enum))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(buf, text...), nil
+}
+
+type encodePlanNullable[Enum any] struct{}
+
+func (encodePlanNullable[Enum]) Encode(value any, buf []byte) ([]byte, error) {
+	nullable := value.(enum.Nullable[Enum])
+	if !nullable.Valid {
+		return nil, nil
+	}
+
+	return encodePlanEnum[Enum]{}.Encode(nullable.Enum, buf)
+}
+
+type encodePlanString struct{}
+
+func (encodePlanString) Encode(value any, buf []byte) ([]byte, error) {
+	return append(buf, value.(string)...), nil
+}
+
+type scanPlanEnum[Enum any] struct{}
+
+func (scanPlanEnum[Enum]) Scan(src []byte, target any) error {
+	if src == nil {
+		return fmt.Errorf("enum %s: cannot scan NULL, use enum.Nullable", enum.TrueNameOf[Enum]())
+	}
+
+	return enum.UnmarshalText(src, target.(*Enum))
+}
+
+type scanPlanNullable[Enum any] struct{}
+
+func (scanPlanNullable[Enum]) Scan(src []byte, target any) error {
+	nullable := target.(*enum.Nullable[Enum])
+	if src == nil {
+		*nullable = enum.Nullable[Enum]{}
+		return nil
+	}
+
+	if err := enum.UnmarshalText(src, &nullable.Enum); err != nil {
+		return err
+	}
+
+	nullable.Valid = true
+	return nil
+}
+
+type scanPlanString struct{}
+
+func (scanPlanString) Scan(src []byte, target any) error {
+	if src == nil {
+		return fmt.Errorf("cannot scan NULL into %T", target)
+	}
+
+	*target.(*string) = string(src)
+	return nil
+}
@@ -0,0 +1,56 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// PostgresEnumType describes the PostgreSQL enum type matching an enum type.
+// It contains the information needed to declare the type in the database or
+// to register it in a driver (e.g. pgx's RegisterType with the OID loaded by
+// LoadType), without this library importing any driver.
+type PostgresEnumType struct {
+	// Name is the name of the type, derived from NameOf in snake case (e.g.
+	// "user_role" for UserRole).
+	Name string
+
+	// Labels are the string representations of all values, in their
+	// registration order.
+	Labels []string
+}
+
+// PostgresType returns the PostgreSQL enum type matching the enum type.
+func PostgresType[Enum any]() PostgresEnumType {
+	labels := []string{}
+	for _, e := range AllRef[Enum]() {
+		labels = append(labels, reprOf[string](e))
+	}
+
+	return PostgresEnumType{Name: toSnakeCase(NameOf[Enum]()), Labels: labels}
+}
+
+// CreateTypeSQL returns the statement creating the PostgreSQL enum type.
+func (t PostgresEnumType) CreateTypeSQL() string {
+	return fmt.Sprintf("CREATE TYPE %s AS ENUM (%s)", t.Name, quoteSQLStrings(t.Labels, PostgresDialect))
+}
+
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Insert an underscore before an upper letter which starts a new
+			// word, e.g. UserRole and HTTPStatus => user_role, http_status.
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
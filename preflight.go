@@ -0,0 +1,94 @@
+package enum
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/xybor-x/enum/internal/core"
+)
+
+// nullableEnum is implemented by Nullable, so the reflection-based helpers can
+// see through it.
+type nullableEnum interface {
+	nullable() (any, bool)
+}
+
+// PreflightMarshal walks the value (following pointers, interfaces, slices,
+// arrays, maps, and struct fields) and reports every invalid enum with its
+// path, for example:
+//
+//	payload.items[17].status: invalid value 9 for Status
+//
+// Paths use the json names of struct fields. Invalid nullable enums are not
+// reported. It returns nil if all enums are valid, otherwise an error joining
+// all reported errors.
+func PreflightMarshal(v any) error {
+	var errs []error
+
+	walker := core.Walker{
+		Visit: func(path string, value reflect.Value, info *core.TypeInfo) {
+			if info.IsValid(value.Interface()) {
+				return
+			}
+
+			err := fmt.Errorf("invalid value %s for %s", formatRaw(value), info.Name)
+			if path != "" {
+				err = fmt.Errorf("%s: %w", path, err)
+			}
+
+			errs = append(errs, err)
+		},
+		Unwrap: unwrapNullable,
+	}
+
+	walker.Walk("", reflect.ValueOf(v))
+	return errors.Join(errs...)
+}
+
+// MarshalJSONStrict runs PreflightMarshal on the value, then serializes it into
+// JSON. Unlike json.Marshal, which stops at the first invalid enum, the
+// returned error reports all invalid enums with their paths.
+func MarshalJSONStrict(v any) ([]byte, error) {
+	if err := PreflightMarshal(v); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(v)
+}
+
+func unwrapNullable(value reflect.Value) (reflect.Value, bool) {
+	if !value.CanInterface() {
+		return reflect.Value{}, false
+	}
+
+	n, ok := value.Interface().(nullableEnum)
+	if !ok {
+		return reflect.Value{}, false
+	}
+
+	inner, valid := n.nullable()
+	if !valid {
+		return reflect.Value{}, true
+	}
+
+	return reflect.ValueOf(inner), true
+}
+
+// formatRaw formats the underlying value of an enum, without calling its
+// String method.
+func formatRaw(value reflect.Value) string {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprint(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprint(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		return fmt.Sprint(value.Float())
+	case reflect.String:
+		return fmt.Sprintf("%q", value.String())
+	default:
+		return fmt.Sprintf("%#v", value.Interface())
+	}
+}
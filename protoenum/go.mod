@@ -0,0 +1,10 @@
+module github.com/xybor-x/enum/protoenum
+
+go 1.21.1
+
+require (
+	github.com/xybor-x/enum v0.3.0
+	google.golang.org/protobuf v1.36.0
+)
+
+require gopkg.in/yaml.v3 v3.0.1 // indirect
@@ -0,0 +1,94 @@
+// Package protoenum creates the values of an enum type of
+// github.com/xybor-x/enum from a protobuf enum, so the enum type doesn't drift
+// when the .proto file gains values.
+//
+//	var roles = protoenum.NewAll[Role, proto.Role]()
+//
+// Each proto value becomes an enum value whose string representation is the
+// proto name, numeric representation is the proto number, and custom
+// representation is the proto value itself.
+package protoenum
+
+import (
+	"fmt"
+
+	"github.com/xybor-x/enum"
+	"github.com/xybor-x/enum/internal/core"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func init() {
+	// The values created by NewAll are owned by its caller.
+	core.MarkHelperPackage()
+}
+
+// Option customizes NewAll.
+type Option func(*config)
+
+type config struct {
+	skip map[string]bool
+}
+
+// Skip doesn't create enum values for the given proto names, e.g. the
+// UNSPECIFIED value.
+func Skip(names ...string) Option {
+	return func(c *config) {
+		for _, name := range names {
+			c.skip[name] = true
+		}
+	}
+}
+
+// NewAll creates an enum value for every value of the protobuf enum P, in
+// their declaration order, and returns them. Names sharing a number
+// (allow_alias) are mapped as aliases of the first one.
+//
+// Like enum.New, it panics if a representation is already mapped.
+func NewAll[Enum any, P protoreflect.Enum](opts ...Option) []Enum {
+	c := config{skip: map[string]bool{}}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	var zero P
+	values := zero.Descriptor().Values()
+
+	for name := range c.skip {
+		if values.ByName(protoreflect.Name(name)) == nil {
+			panic(fmt.Sprintf("enum %s: %s has no value %s",
+				enum.TrueNameOf[Enum](), zero.Descriptor().FullName(), name))
+		}
+	}
+
+	var numbers []protoreflect.EnumNumber
+	reprs := map[protoreflect.EnumNumber][]enum.Option{}
+	for i := 0; i < values.Len(); i++ {
+		value := values.Get(i)
+		name := string(value.Name())
+		if c.skip[name] {
+			continue
+		}
+
+		number := value.Number()
+		if _, ok := reprs[number]; ok {
+			reprs[number] = append(reprs[number], enum.Alias(name))
+			continue
+		}
+
+		p, ok := zero.Type().New(number).(P)
+		if !ok {
+			panic(fmt.Sprintf("enum %s: cannot create %s(%d)",
+				enum.TrueNameOf[Enum](), zero.Descriptor().FullName(), number))
+		}
+
+		numbers = append(numbers, number)
+		reprs[number] = []enum.Option{enum.Str(name), enum.Num(int32(number)), enum.Repr(p)}
+	}
+
+	result := make([]Enum, 0, len(numbers))
+	for _, number := range numbers {
+		result = append(result, enum.NewOpts[Enum](reprs[number]...))
+	}
+
+	return result
+}
@@ -0,0 +1,48 @@
+package enum
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Random returns a value of the enum type picked uniformly by the default
+// source of math/rand, e.g. to seed test databases. It panics if the enum type
+// has no registered value.
+func Random[Enum any]() Enum {
+	return pickRandom[Enum](rand.Intn, 1)[0]
+}
+
+// RandomN returns n values of the enum type, each picked uniformly by the
+// default source of math/rand. It panics if the enum type has no registered
+// value.
+func RandomN[Enum any](n int) []Enum {
+	return pickRandom[Enum](rand.Intn, n)
+}
+
+// Generate returns a value of the enum type picked uniformly by r, e.g. for
+// property-based tests. It panics if the enum type has no registered value.
+//
+// The enum wrappers implement quick.Generator of testing/quick with it.
+func Generate[Enum any](r *rand.Rand) Enum {
+	return pickRandom[Enum](r.Intn, 1)[0]
+}
+
+// GenerateN returns n values of the enum type, each picked uniformly by r. It
+// panics if the enum type has no registered value.
+func GenerateN[Enum any](r *rand.Rand, n int) []Enum {
+	return pickRandom[Enum](r.Intn, n)
+}
+
+func pickRandom[Enum any](intn func(int) int, n int) []Enum {
+	values := AllRef[Enum]()
+	if len(values) == 0 {
+		panic(fmt.Sprintf("enum %s: no registered value to generate", TrueNameOf[Enum]()))
+	}
+
+	picked := make([]Enum, n)
+	for i := range picked {
+		picked[i] = values[intn(len(values))]
+	}
+
+	return picked
+}
@@ -0,0 +1,25 @@
+package registry
+
+var globalmap = &Map{}
+
+// Get2 returns the value associated with the key in the global map, and
+// whether the key exists.
+func Get2[V any](key Keyer[V]) (V, bool) {
+	return Get2M(globalmap, key)
+}
+
+// Get returns the value associated with the key in the global map. It returns
+// the zero value if the key does not exist.
+func Get[V any](key Keyer[V]) V {
+	return GetM(globalmap, key)
+}
+
+// Set associates the value with the key in the global map.
+func Set[V any](key Keyer[V], v V) {
+	SetM(globalmap, key, v)
+}
+
+// Delete removes the key from the global map.
+func Delete[V any](key Keyer[V]) {
+	DeleteM(globalmap, key)
+}
@@ -0,0 +1,85 @@
+// Package registry provides the typed heterogeneous map used by the enum
+// package to store its registry. It is exposed for advanced users building
+// their own enum-adjacent registries (e.g. permission matrices keyed by enum
+// pairs) with the same performance characteristics as the enum package.
+//
+// Every key type infers the type of its value via the InferValue method, so
+// values are stored and retrieved type-safely without any type assertion at
+// call sites:
+//
+//	type permissionKey struct{ role Role; resource Resource }
+//
+//	func (permissionKey) InferValue() bool { panic("not implemented") }
+//
+//	registry.Set(permissionKey{RoleAdmin, ResourceUser}, true)
+//	allowed := registry.Get(permissionKey{RoleAdmin, ResourceUser}) // bool
+//
+// The InferValue method is never called, it only exists for type inference.
+// Keys are compared by ==, so the key type must be comparable.
+//
+// The API of this package follows the compatibility guarantees of the enum
+// module.
+//
+// A Map is safe for concurrent use. It is optimized for keys which are written
+// once (e.g. during initialization) and read many times afterward: reads of
+// existing keys do not lock nor write to shared memory, so their throughput
+// scales with the number of cores, even while other keys are being written.
+package registry
+
+import "sync"
+
+// Keyer is implemented by all key types. The type parameter V is the type of
+// the value associated with the key.
+type Keyer[V any] interface {
+	InferValue() V
+}
+
+// Map is a heterogeneous map whose values are typed by their keys. The zero
+// value is an empty map ready to use. A Map must not be copied after first use.
+type Map struct {
+	data sync.Map
+}
+
+// Get2M returns the value associated with the key in the map, and whether the
+// key exists.
+func Get2M[V any](m *Map, key Keyer[V]) (V, bool) {
+	var zero V
+	val, exists := m.data.Load(key)
+	if !exists {
+		return zero, false
+	}
+
+	// Type assertion can only fail if V is an interface. In that
+	// case, if the map has a `nil` in it, Go won't be able to
+	// type assert that nil into the interface value. (Note a nil
+	// *pointer* type asserts just fine, because it is still
+	// carrying a concrete type. Only nil interfaces lack any
+	// concrete type.) So if the type assertion fails, it must be
+	// a nil, here played by the zero we declared above, which
+	// must be `nil` even though the compiler can't realize that
+	// `nil` would be safe here.
+	finalVal, canAssert := val.(V)
+	if canAssert {
+		return finalVal, true
+	} else {
+		return zero, true
+	}
+}
+
+// GetM returns the value associated with the key in the map. It returns the
+// zero value if the key does not exist.
+func GetM[V any](m *Map, key Keyer[V]) V {
+	v, _ := Get2M(m, key)
+	return v
+}
+
+// SetM associates the value with the key in the map.
+func SetM[V any](m *Map, key Keyer[V], val V) {
+	m.data.Store(key, val)
+}
+
+// DeleteM removes the key from the map. It does nothing if the key does not
+// exist.
+func DeleteM[V any](m *Map, key Keyer[V]) {
+	m.data.Delete(key)
+}
@@ -4,6 +4,10 @@ import (
 	"database/sql/driver"
 	"encoding/xml"
 	"fmt"
+	"io"
+	"log/slog"
+	"math/rand"
+	"reflect"
 
 	"github.com/xybor-x/enum/internal/core"
 	"gopkg.in/yaml.v3"
@@ -27,6 +31,12 @@ func (e SafeEnum[underlyingEnum]) IsValid() bool {
 	return IsValid(e)
 }
 
+// IsZero reports whether the enum is the zero value of SafeEnum. It allows the
+// json omitzero tag option (Go 1.24) to omit unset enums.
+func (e SafeEnum[underlyingEnum]) IsZero() bool {
+	return e.inner == ""
+}
+
 func (e SafeEnum[underlyingEnum]) MarshalJSON() ([]byte, error) {
 	return MarshalJSON(e)
 }
@@ -51,6 +61,62 @@ func (e *SafeEnum[underlyingEnum]) UnmarshalYAML(node *yaml.Node) error {
 	return UnmarshalYAML(node, e)
 }
 
+func (e SafeEnum[underlyingEnum]) MarshalTOML() ([]byte, error) {
+	return MarshalTOML(e)
+}
+
+func (e *SafeEnum[underlyingEnum]) UnmarshalTOML(value any) error {
+	return UnmarshalTOML(value, e)
+}
+
+func (e SafeEnum[underlyingEnum]) MarshalGQL(w io.Writer) {
+	MarshalGQL(w, e)
+}
+
+func (e *SafeEnum[underlyingEnum]) UnmarshalGQL(value any) error {
+	return UnmarshalGQL(value, e)
+}
+
+func (e SafeEnum[underlyingEnum]) MarshalText() ([]byte, error) {
+	return MarshalText(e)
+}
+
+func (e *SafeEnum[underlyingEnum]) UnmarshalText(data []byte) error {
+	return UnmarshalText(data, e)
+}
+
+func (e SafeEnum[underlyingEnum]) MarshalBinary() ([]byte, error) {
+	return MarshalBinary(e)
+}
+
+func (e *SafeEnum[underlyingEnum]) UnmarshalBinary(data []byte) error {
+	return UnmarshalBinary(data, e)
+}
+
+func (e SafeEnum[underlyingEnum]) GobEncode() ([]byte, error) {
+	return GobEncode(e)
+}
+
+func (e *SafeEnum[underlyingEnum]) GobDecode(data []byte) error {
+	return GobDecode(data, e)
+}
+
+func (e SafeEnum[underlyingEnum]) MarshalBSONValue() (byte, []byte, error) {
+	return MarshalBSONValue(e)
+}
+
+func (e *SafeEnum[underlyingEnum]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return UnmarshalBSONValue(typ, data, e)
+}
+
+func (e SafeEnum[underlyingEnum]) MarshalCBOR() ([]byte, error) {
+	return MarshalCBOR(e)
+}
+
+func (e *SafeEnum[underlyingEnum]) UnmarshalCBOR(data []byte) error {
+	return UnmarshalCBOR(data, e)
+}
+
 func (e SafeEnum[underlyingEnum]) Value() (driver.Value, error) {
 	return ValueSQL(e)
 }
@@ -59,8 +125,23 @@ func (e *SafeEnum[underlyingEnum]) Scan(a any) error {
 	return ScanSQL(a, e)
 }
 
+// GormDataType returns the column type of the enum for GORM migrations (see
+// SetGormDataType).
+func (e SafeEnum[underlyingEnum]) GormDataType() string {
+	return GormDataType[SafeEnum[underlyingEnum]]()
+}
+
+// Int returns the int representation of the enum. It panics if the enum is
+// invalid, unless LegacyIntSentinel is enabled. Use IntE or IntOr to handle
+// invalid enums.
 func (e SafeEnum[underlyingEnum]) Int() int {
-	return MustTo[int](e)
+	return mustInt(e)
+}
+
+// IntE returns the int representation of the enum, or an error if the enum is
+// invalid.
+func (e SafeEnum[underlyingEnum]) IntE() (int, error) {
+	return intE(e)
 }
 
 // To returns the underlying representation of this enum.
@@ -72,6 +153,62 @@ func (e SafeEnum[underlyingEnum]) String() string {
 	return ToString(e)
 }
 
+// StringOr returns the string representation of the enum, or def if the enum
+// is invalid.
+func (e SafeEnum[underlyingEnum]) StringOr(def string) string {
+	if str, ok := To[string](e); ok {
+		return str
+	}
+
+	return def
+}
+
+// IntOr returns the int representation of the enum, or def if the enum is
+// invalid or has no int representation.
+func (e SafeEnum[underlyingEnum]) IntOr(def int) int {
+	if i, ok := To[int](e); ok {
+		return i
+	}
+
+	return def
+}
+
+// Enum returns the values of the enum type as serialized in JSON (see
+// OpenAPIEnum), for the schema reflector of swaggest/jsonschema-go.
+func (e SafeEnum[underlyingEnum]) Enum() []any {
+	return OpenAPIEnum[SafeEnum[underlyingEnum]]()
+}
+
+// JSONSchemaBytes returns the JSON schema of the enum type as serialized in
+// JSON, for the schema reflector of swaggest/jsonschema-go.
+func (e SafeEnum[underlyingEnum]) JSONSchemaBytes() ([]byte, error) {
+	return openAPISchemaBytes[SafeEnum[underlyingEnum]]()
+}
+
+// Generate implements quick.Generator, returning a registered value picked
+// uniformly (see the Generate function).
+func (e SafeEnum[underlyingEnum]) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(Generate[SafeEnum[underlyingEnum]](r))
+}
+
+// Compare compares the enum with another one (see the Compare function), e.g.
+// for slices.SortFunc.
+func (e SafeEnum[underlyingEnum]) Compare(other SafeEnum[underlyingEnum]) int {
+	return Compare(e, other)
+}
+
+// Less reports whether the enum sorts before another one (see the Compare
+// function).
+func (e SafeEnum[underlyingEnum]) Less(other SafeEnum[underlyingEnum]) bool {
+	return Compare(e, other) < 0
+}
+
+// LogValue implements slog.LogValuer, logging the string representation of
+// the enum (see SlogValue).
+func (e SafeEnum[underlyingEnum]) LogValue() slog.Value {
+	return SlogValue(e)
+}
+
 func (e SafeEnum[underlyingEnum]) GoString() string {
 	if !IsValid(e) {
 		return "<nil>"
@@ -0,0 +1,69 @@
+package enum
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"unicode"
+)
+
+// ScanFmt reads a whitespace-delimited token and resolves it as the string
+// representation of an enum value, or as the numeric representation with the
+// %d verb. It implements fmt.Scanner for FmtScanner.
+//
+// The enum wrappers can't implement fmt.Scanner themselves, as their Scan
+// method implements sql.Scanner.
+func ScanFmt[Enum any](state fmt.ScanState, verb rune, t *Enum) error {
+	if verb != 'v' && verb != 's' && verb != 'd' {
+		return fmt.Errorf("enum %s: not support verb %%%c", TrueNameOf[Enum](), verb)
+	}
+
+	token, err := state.Token(true, func(r rune) bool { return !unicode.IsSpace(r) })
+	if err != nil {
+		return err
+	}
+
+	if len(token) == 0 {
+		return io.ErrUnexpectedEOF
+	}
+
+	if verb == 'd' {
+		n, err := strconv.ParseInt(string(token), 10, 64)
+		if err != nil {
+			return fmt.Errorf("enum %s: invalid number %s", TrueNameOf[Enum](), token)
+		}
+
+		enum, ok := FromNumber[Enum](n)
+		if !ok {
+			return unknownNumberError[Enum](n)
+		}
+
+		*t = enum
+		return nil
+	}
+
+	enum, ok := FromString[Enum](string(token))
+	if !ok {
+		return unknownStringError[Enum](string(token))
+	}
+
+	*t = enum
+	return nil
+}
+
+// FmtScanner adapts an enum variable to the fmt.Scanner interface (see
+// ScanFmt), so it can be scanned by fmt.Sscan, fmt.Fscanf, etc.:
+//
+//	var role Role
+//	_, err := fmt.Sscan("admin", enum.FmtScanner(&role))
+func FmtScanner[Enum any](value *Enum) fmt.Scanner {
+	return fmtScanner[Enum]{value: value}
+}
+
+type fmtScanner[Enum any] struct {
+	value *Enum
+}
+
+func (s fmtScanner[Enum]) Scan(state fmt.ScanState, verb rune) error {
+	return ScanFmt(state, verb, s.value)
+}
@@ -0,0 +1,79 @@
+package enum
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// JSONSchema is the JSON schema of an enum type, as serialized in JSON.
+type JSONSchema struct {
+	// Type is "string", or "integer" or "number" with
+	// SetJSONFormat(JSONNumber).
+	Type string `json:"type"`
+
+	// Enum contains the values as serialized in JSON (see OpenAPIEnum).
+	Enum []any `json:"enum"`
+
+	// Descriptions contains the description of each value in Enum, at the
+	// same index. Values without description fall back to their string
+	// representations. It is omitted if no value has a description.
+	Descriptions []string `json:"x-enum-descriptions,omitempty"`
+}
+
+// SchemaOf returns the JSON schema of the enum type, e.g. to be embedded in an
+// OpenAPI document. It follows the JSON format of the enum type (see
+// SetJSONFormat).
+func SchemaOf[Enum any]() JSONSchema {
+	schema := JSONSchema{Type: "string", Enum: []any{}}
+
+	number := registry.Get(mtkey.JSONNumberFormat[Enum]())
+	if number {
+		schema.Type = "integer"
+	}
+
+	hasDesc := false
+	for _, value := range DescribedValues[Enum]() {
+		if number {
+			f := reprOf[float64](value.Value)
+			if f != math.Trunc(f) {
+				schema.Type = "number"
+			}
+			schema.Enum = append(schema.Enum, f)
+		} else {
+			schema.Enum = append(schema.Enum, value.Name)
+		}
+
+		desc := value.Desc
+		if desc == "" {
+			desc = value.Name
+		} else {
+			hasDesc = true
+		}
+		schema.Descriptions = append(schema.Descriptions, desc)
+	}
+
+	if !hasDesc {
+		schema.Descriptions = nil
+	}
+
+	return schema
+}
+
+// OpenAPIEnum returns the values of the enum type as serialized in JSON, i.e.
+// the string representations, or the numeric representations with
+// SetJSONFormat(JSONNumber), e.g. for the enum list of a hand-built OpenAPI
+// schema. It reflects the values registered at the time of the call.
+//
+// The enum wrappers implement the Enum and RawExposer interfaces of
+// swaggest/jsonschema-go with it.
+func OpenAPIEnum[Enum any]() []any {
+	return SchemaOf[Enum]().Enum
+}
+
+// openAPISchemaBytes returns the JSON schema of the enum type (see SchemaOf).
+func openAPISchemaBytes[Enum any]() ([]byte, error) {
+	return json.Marshal(SchemaOf[Enum]())
+}
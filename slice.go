@@ -0,0 +1,180 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// SliceSerde is a slice of enum values, serialized as a JSON array of their
+// serialized forms (see MarshalJSON), e.g. ["admin","user"]. It can be stored
+// in JSON (or text) SQL columns and used in API payloads, for any enum type,
+// including plain enums without methods.
+//
+// Both nil and empty slices are serialized as []. Use
+// Nullable[SliceSerde[Enum]] to store NULL instead of an empty array.
+//
+// Every element is validated when (de)serializing, and the error reports the
+// index of the first invalid element.
+type SliceSerde[Enum any] []Enum
+
+// Enums returns the enum values of the slice.
+func (s SliceSerde[Enum]) Enums() []Enum {
+	return []Enum(s)
+}
+
+// Append appends the enum values to the slice.
+func (s *SliceSerde[Enum]) Append(values ...Enum) {
+	*s = append(*s, values...)
+}
+
+func (s SliceSerde[Enum]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(s)
+}
+
+func (s *SliceSerde[Enum]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONArray[Enum]("SliceSerde", data)
+	if err != nil {
+		return err
+	}
+
+	*s = values
+	return nil
+}
+
+// Value implements the driver.Valuer interface, it serializes the slice into a
+// JSON array.
+func (s SliceSerde[Enum]) Value() (driver.Value, error) {
+	return s.MarshalJSON()
+}
+
+// Scan implements the sql.Scanner interface, it deserializes a JSON array
+// stored as string or []byte into the slice.
+func (s *SliceSerde[Enum]) Scan(a any) error {
+	var data []byte
+	switch t := a.(type) {
+	case string:
+		data = []byte(t)
+	case []byte:
+		data = t
+	default:
+		return fmt.Errorf("enum %s: SliceSerde does not support type %v", TrueNameOf[Enum](), reflect.TypeOf(a))
+	}
+
+	return json.Unmarshal(data, s)
+}
+
+// CSVSlice is a slice of enum values, stored in SQL text columns as their
+// string representations separated by commas, e.g. "admin,user".
+//
+// Both nil and empty slices are stored as an empty string, which is scanned
+// into an empty slice. Use Nullable[CSVSlice[Enum]] to store NULL instead.
+//
+// In JSON, e.g. in API payloads, the slice is serialized as an array, as
+// SliceSerde is.
+type CSVSlice[Enum any] []Enum
+
+// Enums returns the enum values of the slice.
+func (s CSVSlice[Enum]) Enums() []Enum {
+	return []Enum(s)
+}
+
+// Append appends the enum values to the slice.
+func (s *CSVSlice[Enum]) Append(values ...Enum) {
+	*s = append(*s, values...)
+}
+
+// MarshalJSON serializes the slice as a JSON array, as SliceSerde does.
+func (s CSVSlice[Enum]) MarshalJSON() ([]byte, error) {
+	return marshalJSONArray(s)
+}
+
+// UnmarshalJSON deserializes a JSON array into the slice, as SliceSerde does.
+func (s *CSVSlice[Enum]) UnmarshalJSON(data []byte) error {
+	values, err := unmarshalJSONArray[Enum]("CSVSlice", data)
+	if err != nil {
+		return err
+	}
+
+	*s = values
+	return nil
+}
+
+// Value implements the driver.Valuer interface, it joins the string
+// representations of the enum values with commas (see JoinValues).
+//
+// It returns an error if a string representation cannot be read back by Scan,
+// i.e. if it is empty, contains a comma, or has leading or trailing spaces.
+func (s CSVSlice[Enum]) Value() (driver.Value, error) {
+	for i, value := range s {
+		str, ok := To[string](value)
+		if ok && (str == "" || strings.Contains(str, ",") || str != strings.TrimSpace(str)) {
+			return nil, fmt.Errorf("index %d: enum %s: string %q cannot be stored in a comma-separated list",
+				i, TrueNameOf[Enum](), str)
+		}
+	}
+
+	return JoinValues(s, ",")
+}
+
+// Scan implements the sql.Scanner interface, it parses a comma-separated list
+// stored as string or []byte into the slice (see ParseList).
+func (s *CSVSlice[Enum]) Scan(a any) error {
+	var data string
+	switch t := a.(type) {
+	case string:
+		data = t
+	case []byte:
+		data = string(t)
+	default:
+		return fmt.Errorf("enum %s: CSVSlice does not support type %v", TrueNameOf[Enum](), reflect.TypeOf(a))
+	}
+
+	values, err := ParseList[Enum](data, ",")
+	if err != nil {
+		return err
+	}
+
+	*s = values
+	return nil
+}
+
+// marshalJSONArray serializes the enum values as a JSON array of their
+// serialized forms (see MarshalJSON). A nil slice is serialized as [].
+func marshalJSONArray[Enum any](values []Enum) ([]byte, error) {
+	elems := make([]json.RawMessage, len(values))
+	for i, value := range values {
+		data, err := MarshalJSON(value)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+
+		elems[i] = data
+	}
+
+	return json.Marshal(elems)
+}
+
+// unmarshalJSONArray deserializes a JSON array of serialized enum values. JSON
+// null is deserialized as a nil slice.
+func unmarshalJSONArray[Enum any](kind string, data []byte) ([]Enum, error) {
+	var elems []json.RawMessage
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return nil, fmt.Errorf("enum %s: %s requires a JSON array, got %s", TrueNameOf[Enum](), kind, data)
+	}
+
+	if elems == nil {
+		return nil, nil
+	}
+
+	values := make([]Enum, len(elems))
+	for i, elem := range elems {
+		if err := UnmarshalJSON(elem, &values[i]); err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+	}
+
+	return values, nil
+}
@@ -0,0 +1,32 @@
+package enum
+
+import (
+	"log/slog"
+	"reflect"
+)
+
+// SlogValue returns the string representation of an enum value as a slog
+// value, so plain enums (e.g. type Role int) are logged as role=admin rather
+// than role=1. Invalid values are logged as their underlying number, or as
+// <nil> if there is none.
+//
+//	logger.Info("login", "role", enum.SlogValue(role))
+//
+// The enum wrappers implement slog.LogValuer with it.
+func SlogValue[Enum any](value Enum) slog.Value {
+	if str, ok := To[string](value); ok {
+		return slog.StringValue(str)
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return slog.Int64Value(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return slog.Uint64Value(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return slog.Float64Value(v.Float())
+	default:
+		return slog.StringValue("<nil>")
+	}
+}
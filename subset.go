@@ -0,0 +1,131 @@
+package enum
+
+import (
+	"fmt"
+
+	"github.com/xybor-x/enum/internal/core"
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// Subset is a subset of the values of an enum type, identified by a marker
+// type S. It is created by DeclareSubsetType.
+type Subset[S, Enum any] struct{}
+
+// DeclareSubsetType declares the values of the subset identified by the marker
+// type S. The marker type is usually an unexported empty struct:
+//
+//	type assignableRoles struct{}
+//
+//	var AssignableRoles = enum.DeclareSubsetType[assignableRoles](RoleUser, RoleGuest)
+//
+// Functions taking a Member[assignableRoles, Role] are then guaranteed to
+// receive a value of the subset, because Member can only be constructed via
+// Subset.Wrap or decoded by Member.UnmarshalJSON, which both validate the
+// membership.
+//
+// It panics if the subset was already declared or if any value is invalid.
+//
+// Note that this function is not thread-safe and should only be called during
+// initialization or other safe execution points to avoid race conditions.
+func DeclareSubsetType[S, Enum any](values ...Enum) Subset[S, Enum] {
+	core.CheckMutable[Enum](core.MetadataMutation, "declare a subset")
+
+	if registry.Get(mtkey.SubsetDeclared[S]()) {
+		panic(fmt.Sprintf("enum %s: subset %s was already declared", TrueNameOf[Enum](), NameOf[S]()))
+	}
+
+	for _, value := range values {
+		if !IsValid(value) {
+			panic(fmt.Sprintf("enum %s (%#v): subset %s contains an invalid value",
+				TrueNameOf[Enum](), value, NameOf[S]()))
+		}
+
+		if registry.Get(mtkey.SubsetMember[S](value)) {
+			panic(fmt.Sprintf("enum %s (%#v): subset %s contains a value twice",
+				TrueNameOf[Enum](), value, NameOf[S]()))
+		}
+
+		registry.Set(mtkey.SubsetMember[S](value), true)
+	}
+
+	registry.Set(mtkey.SubsetValues[S, Enum](), values)
+	registry.Set(mtkey.SubsetDeclared[S](), true)
+	return Subset[S, Enum]{}
+}
+
+// Contains returns true if the value is a member of the subset.
+func (Subset[S, Enum]) Contains(value Enum) bool {
+	return registry.Get(mtkey.SubsetMember[S](value))
+}
+
+// Values returns all values of the subset, in their declaration order.
+func (Subset[S, Enum]) Values() []Enum {
+	return registry.Get(mtkey.SubsetValues[S, Enum]())
+}
+
+// Wrap returns the value as a member of the subset. It returns an error if the
+// value is not in the subset.
+func (s Subset[S, Enum]) Wrap(value Enum) (Member[S, Enum], error) {
+	if !s.Contains(value) {
+		return Member[S, Enum]{}, notMemberError[S](value)
+	}
+
+	return Member[S, Enum]{value: value, valid: true}, nil
+}
+
+// Member is a value of the enum type which is guaranteed to be in the subset
+// identified by the marker type S. Use Subset.Wrap to construct it; the zero
+// Member is not a member of any subset.
+type Member[S, Enum any] struct {
+	value Enum
+	valid bool
+}
+
+// Unwrap returns the enum value.
+func (m Member[S, Enum]) Unwrap() Enum {
+	return m.value
+}
+
+// IsValid returns true if the member was constructed from a value of the
+// subset, i.e. it is not the zero Member.
+func (m Member[S, Enum]) IsValid() bool {
+	return m.valid
+}
+
+func (m Member[S, Enum]) String() string {
+	return ToString(m.value)
+}
+
+func (m Member[S, Enum]) MarshalJSON() ([]byte, error) {
+	if !m.valid {
+		return nil, fmt.Errorf("enum %s: zero member of subset %s", TrueNameOf[Enum](), NameOf[S]())
+	}
+
+	return MarshalJSON(m.value)
+}
+
+// UnmarshalJSON decodes the enum value, then validates that it is a member of
+// the subset.
+func (m *Member[S, Enum]) UnmarshalJSON(data []byte) error {
+	var value Enum
+	if err := UnmarshalJSON(data, &value); err != nil {
+		return err
+	}
+
+	member, err := Subset[S, Enum]{}.Wrap(value)
+	if err != nil {
+		return err
+	}
+
+	*m = member
+	return nil
+}
+
+func notMemberError[S, Enum any](value Enum) error {
+	if !IsValid(value) {
+		return fmt.Errorf("enum %s: %w %#v is not in subset %s", TrueNameOf[Enum](), ErrInvalidEnum, value, NameOf[S]())
+	}
+
+	return fmt.Errorf("enum %s: %s is not in subset %s", TrueNameOf[Enum](), ToString(value), NameOf[S]())
+}
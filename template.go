@@ -0,0 +1,102 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/xybor-x/enum/internal/core"
+)
+
+// TemplateFuncs returns the functions for text/template and html/template to
+// work with the registered enum types, which are referred to by their names
+// (see NameOf) or true names (see TrueNameOf):
+//
+//   - enumAll "Role" returns all values of the enum type.
+//   - enumString value returns the string representation of the enum value.
+//   - enumFrom "Role" "admin" returns the enum value of the string, which is
+//     resolved as by ParseString, e.g. with the string matcher and parse
+//     options of the enum type.
+//
+// For example:
+//
+//	tmpl := template.Must(template.New("roles").Funcs(enum.TemplateFuncs()).Parse(
+//		`{{range enumAll "Role"}}<option>{{enumString .}}</option>{{end}}`))
+//
+// The functions return an error, which stops the execution of the template, if
+// the enum type or the value is unknown, or if the name is shared by several
+// enum types.
+func TemplateFuncs() map[string]any {
+	return map[string]any{
+		"enumAll": func(name string) ([]any, error) {
+			info, err := lookupTypeByName(name)
+			if err != nil {
+				return nil, err
+			}
+
+			return info.Values(), nil
+		},
+		"enumString": func(value any) (string, error) {
+			info, ok := core.LookupType(reflect.TypeOf(value))
+			if !ok {
+				return "", fmt.Errorf("enum: %T is not an enum type", value)
+			}
+
+			str, ok := info.String(value)
+			if !ok {
+				return "", fmt.Errorf("enum %s: %w %#v", info.TrueName, ErrInvalidEnum, value)
+			}
+
+			return str, nil
+		},
+		"enumFrom": func(name, s string) (any, error) {
+			info, err := lookupTypeByName(name)
+			if err != nil {
+				return nil, err
+			}
+
+			value, ok := info.FromString(s)
+			if !ok {
+				return nil, &ParseError{Type: info.TrueName, Input: s, Allowed: typeStrings(info)}
+			}
+
+			return value, nil
+		},
+	}
+}
+
+// typeStrings returns the string representations of all values of the enum
+// type, as AllStrings.
+func typeStrings(info *core.TypeInfo) []string {
+	values := info.Values()
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		if str, ok := info.String(value); ok {
+			strs = append(strs, str)
+		}
+	}
+
+	return strs
+}
+
+// lookupTypeByName returns the registered enum type whose name or true name is
+// the given name.
+func lookupTypeByName(name string) (*core.TypeInfo, error) {
+	var found *core.TypeInfo
+	for _, info := range core.AllTypes() {
+		if info.Name != name && info.TrueName != name {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("enum %s: ambiguous name, several enum types are named so", name)
+		}
+
+		found = info
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("enum %s: unknown enum type", name)
+	}
+
+	return found, nil
+}
@@ -0,0 +1,147 @@
+package testing_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMapLegacyString(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleUser  = enum.MapLegacyString(enum.New[Role]("user"), "member")
+		RoleAdmin = enum.MapLegacyString(enum.New[Role]("admin"), "superuser", "root")
+	)
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE accounts (id INTEGER PRIMARY KEY, role TEXT);`)
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`INSERT INTO accounts (role) VALUES ('admin'), ('superuser'), ('member')`)
+	assert.NoError(t, err)
+
+	rows, err := db.Query(`SELECT role FROM accounts ORDER BY id`)
+	assert.NoError(t, err)
+	defer rows.Close()
+
+	var scanned []Role
+	for rows.Next() {
+		var r Role
+		assert.NoError(t, rows.Scan(&r))
+		scanned = append(scanned, r)
+	}
+	assert.Equal(t, []Role{RoleAdmin, RoleAdmin, RoleUser}, scanned)
+
+	var r Role
+	assert.NoError(t, json.Unmarshal([]byte(`"root"`), &r))
+	assert.Equal(t, RoleAdmin, r)
+
+	// Only the canonical strings are emitted.
+	value, err := r.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", value)
+
+	data, err := json.Marshal(r)
+	assert.NoError(t, err)
+	assert.Equal(t, `"admin"`, string(data))
+
+	assert.Equal(t, []Role{RoleUser, RoleAdmin}, enum.All[Role]())
+}
+
+func TestMapLegacyStringConflicts(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.MapLegacyString(enum.New[Role]("admin"), "superuser")
+	)
+
+	assert.PanicsWithValue(t, "enum WrapEnum[role] (0 (user)): alias admin was already mapped to admin",
+		func() { enum.MapLegacyString(RoleUser, "admin") })
+	assert.PanicsWithValue(t, "enum WrapEnum[role] (0 (user)): alias superuser was already mapped to admin",
+		func() { enum.MapLegacyString(RoleUser, "superuser") })
+	assert.PanicsWithValue(t, "enum WrapEnum[role] (7): cannot map a legacy string to an invalid value",
+		func() { enum.MapLegacyString(Role(7), "guest") })
+
+	// Canonical strings mapped later must not collide with legacy strings.
+	assert.PanicsWithValue(t, "enum WrapEnum[role] (2): string superuser was already mapped to admin",
+		func() { enum.New[Role]("superuser") })
+
+	_, ok := enum.FromString[Role]("superuser")
+	assert.True(t, ok)
+	assert.Equal(t, "admin", RoleAdmin.String())
+}
+
+func TestMapAlias(t *testing.T) {
+	type Tier int
+
+	var (
+		TierFree = enum.New[Tier]("free")
+		TierPro  = enum.MapAlias(enum.New[Tier]("pro"), "professional", "premium")
+	)
+
+	got, ok := enum.FromString[Tier]("premium")
+	assert.True(t, ok)
+	assert.Equal(t, TierPro, got)
+
+	assert.NoError(t, enum.UnmarshalJSON([]byte(`"professional"`), &got))
+	assert.Equal(t, TierPro, got)
+
+	got = TierFree
+	var node yaml.Node
+	assert.NoError(t, node.Encode("premium"))
+	assert.NoError(t, enum.UnmarshalYAML(&node, &got))
+	assert.Equal(t, TierPro, got)
+
+	got = TierFree
+	assert.NoError(t, enum.ScanSQL([]byte("professional"), &got))
+	assert.Equal(t, TierPro, got)
+
+	// Only the canonical string is emitted.
+	assert.Equal(t, "pro", enum.ToString(got))
+
+	data, err := enum.MarshalJSON(got)
+	assert.NoError(t, err)
+	assert.Equal(t, `"pro"`, string(data))
+
+	value, err := enum.ValueSQL(got)
+	assert.NoError(t, err)
+	assert.Equal(t, "pro", value)
+
+	assert.Equal(t, []string{"free", "pro"}, enum.AllStrings[Tier]())
+	assert.Equal(t, []string{"professional", "premium"}, enum.AliasesOf(TierPro))
+	assert.Nil(t, enum.AliasesOf(TierFree))
+}
+
+func TestMapAliasConflicts(t *testing.T) {
+	type Tier int
+
+	var (
+		TierFree = enum.New[Tier]("free")
+		TierPro  = enum.MapAlias(enum.New[Tier]("pro"), "premium")
+	)
+
+	assert.PanicsWithValue(t, "enum Tier (0): alias pro was already mapped to 1",
+		func() { enum.MapAlias(TierFree, "pro") })
+	assert.PanicsWithValue(t, "enum Tier (0): alias premium was already mapped to 1",
+		func() { enum.MapAlias(TierFree, "premium") })
+	assert.PanicsWithValue(t, "enum Tier (7): cannot map an alias to an invalid value",
+		func() { enum.MapAlias(Tier(7), "basic") })
+
+	enum.Finalize[Tier]()
+	assert.PanicsWithValue(t, "enum Tier: the enum was already finalized",
+		func() { enum.MapAlias(TierPro, "professional") })
+
+	_, ok := enum.FromString[Tier]("professional")
+	assert.False(t, ok)
+}
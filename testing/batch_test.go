@@ -0,0 +1,156 @@
+package testing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestFromStrings(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	roles, err := enum.FromStrings[Role]([]string{"admin", "user", "admin"})
+	assert.NoError(t, err)
+	assert.Equal(t, []Role{RoleAdmin, RoleUser, RoleAdmin}, roles)
+
+	roles, err = enum.FromStrings[Role]([]string{})
+	assert.NoError(t, err)
+	assert.NotNil(t, roles)
+	assert.Empty(t, roles)
+
+	_, err = enum.FromStrings[Role]([]string{"admin", "moderator", "user", "guest"})
+	assert.EqualError(t, err, "index 1: enum Role: unknown string moderator (allowed values: user, admin)\n"+
+		"index 3: enum Role: unknown string guest (allowed values: user, admin)")
+	assert.ErrorIs(t, err, enum.ErrUnknownString)
+
+	var parseErr *enum.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "moderator", parseErr.Input)
+
+	assert.Equal(t, []Role{RoleUser}, enum.MustFromStrings[Role]([]string{"user"}))
+	assert.Panics(t, func() { enum.MustFromStrings[Role]([]string{"guest"}) })
+}
+
+func TestToStrings(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	strs, err := enum.ToStrings([]Role{RoleAdmin, RoleUser})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"admin", "user"}, strs)
+
+	strs, err = enum.ToStrings([]Role{})
+	assert.NoError(t, err)
+	assert.NotNil(t, strs)
+	assert.Empty(t, strs)
+
+	strs, err = enum.ToStrings([]Role{RoleAdmin, Role(42), Role(-1)})
+	assert.EqualError(t, err, "index 1: enum Role: invalid value 42\nindex 2: enum Role: invalid value -1")
+	assert.ErrorIs(t, err, enum.ErrInvalidEnum)
+	assert.Equal(t, []string{"admin", "", ""}, strs)
+
+	assert.Equal(t, []string{"user"}, enum.MustToStrings([]Role{RoleUser}))
+	assert.PanicsWithValue(t, "index 0: enum Role: invalid value 42", func() { enum.MustToStrings([]Role{Role(42)}) })
+}
+
+func TestParseList(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	roles, err := enum.ParseList[Role]("admin,user", ",")
+	assert.NoError(t, err)
+	assert.Equal(t, []Role{RoleAdmin, RoleUser}, roles)
+
+	roles, err = enum.ParseList[Role](" admin , user ,, admin ", ",")
+	assert.NoError(t, err)
+	assert.Equal(t, []Role{RoleAdmin, RoleUser, RoleAdmin}, roles)
+
+	roles, err = enum.ParseList[Role]("user | admin", "|")
+	assert.NoError(t, err)
+	assert.Equal(t, []Role{RoleUser, RoleAdmin}, roles)
+
+	roles, err = enum.ParseList[Role]("", ",")
+	assert.NoError(t, err)
+	assert.NotNil(t, roles)
+	assert.Empty(t, roles)
+
+	roles, err = enum.ParseList[Role]("admin, moderator,user", ",")
+	assert.EqualError(t, err, "token 1: enum Role: unknown string moderator (allowed values: user, admin)")
+	assert.ErrorIs(t, err, enum.ErrUnknownString)
+	assert.Equal(t, []Role{RoleAdmin, RoleUser}, roles)
+}
+
+func TestParseListParseOptions(t *testing.T) {
+	type Role int
+
+	RoleReadOnly := enum.New[Role]("read_only")
+	enum.SetParseOptions[Role](enum.CaseInsensitive(), enum.ReplaceRunes(map[rune]rune{'-': '_'}))
+
+	roles, err := enum.ParseList[Role]("Read-Only, READ_ONLY", ",")
+	assert.NoError(t, err)
+	assert.Equal(t, []Role{RoleReadOnly, RoleReadOnly}, roles)
+}
+
+func TestFormatList(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	s, err := enum.FormatList([]Role{RoleAdmin, RoleUser}, ",")
+	assert.NoError(t, err)
+	assert.Equal(t, "admin,user", s)
+
+	roles, err := enum.ParseList[Role](s, ",")
+	assert.NoError(t, err)
+	assert.Equal(t, []Role{RoleAdmin, RoleUser}, roles)
+
+	_, err = enum.FormatList([]Role{RoleAdmin, Role(42)}, ",")
+	assert.EqualError(t, err, "index 1: enum Role: invalid value 42")
+}
+
+func TestJoin(t *testing.T) {
+	type Role int
+
+	assert.Equal(t, "", enum.Join[Role](", "))
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.NewOpts[Role](enum.Str("admin"), enum.Alias("root"))
+		_         = enum.New[Role]("moderator")
+	)
+
+	assert.Equal(t, "user, admin, moderator", enum.Join[Role](", "))
+	assert.Equal(t, "user|admin|moderator", enum.Join[Role]("|"))
+
+	_, err := enum.ParseString[Role]("guest")
+	assert.EqualError(t, err, "enum Role: unknown string guest (allowed values: "+enum.Join[Role](", ")+")")
+
+	s, err := enum.JoinValues([]Role{RoleAdmin, RoleUser, RoleAdmin}, ", ")
+	assert.NoError(t, err)
+	assert.Equal(t, "admin, user, admin", s)
+
+	s, err = enum.JoinValues([]Role{}, ", ")
+	assert.NoError(t, err)
+	assert.Equal(t, "", s)
+
+	_, err = enum.JoinValues([]Role{RoleAdmin, Role(42)}, ", ")
+	assert.EqualError(t, err, "index 1: enum Role: invalid value 42")
+}
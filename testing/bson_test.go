@@ -0,0 +1,168 @@
+package testing_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+// The bson.ValueMarshaler and bson.ValueUnmarshaler interfaces of the MongoDB
+// driver (v2).
+type bsonValueMarshaler interface {
+	MarshalBSONValue() (typ byte, data []byte, err error)
+}
+
+type bsonValueUnmarshaler interface {
+	UnmarshalBSONValue(typ byte, data []byte) error
+}
+
+// bsonElement encodes a document element like bson.Marshal does.
+func bsonElement(key string, typ byte, value []byte) []byte {
+	element := append([]byte{typ}, key...)
+	element = append(element, 0x00)
+	return append(element, value...)
+}
+
+// bsonDocument encodes a document from its elements like bson.Marshal does.
+func bsonDocument(elements ...[]byte) []byte {
+	body := bytes.Join(elements, nil)
+	doc := binary.LittleEndian.AppendUint32(nil, uint32(4+len(body)+1))
+	doc = append(doc, body...)
+	return append(doc, 0x00)
+}
+
+// bsonLookup returns the type and the value of the element of a document
+// containing only string, int32 and int64 elements.
+func bsonLookup(t *testing.T, doc []byte, key string) (byte, []byte) {
+	rest := doc[4 : len(doc)-1]
+	for len(rest) > 0 {
+		typ := rest[0]
+		end := bytes.IndexByte(rest[1:], 0x00) + 1
+		name := string(rest[1:end])
+		rest = rest[end+1:]
+
+		var size int
+		switch typ {
+		case 0x02:
+			size = 4 + int(binary.LittleEndian.Uint32(rest))
+		case 0x10:
+			size = 4
+		case 0x12:
+			size = 8
+		default:
+			t.Fatalf("unexpected bson type 0x%02x", typ)
+		}
+
+		if name == key {
+			return typ, rest[:size]
+		}
+
+		rest = rest[size:]
+	}
+
+	t.Fatalf("key %s not found", key)
+	return 0, nil
+}
+
+func bsonString(s string) []byte {
+	value := binary.LittleEndian.AppendUint32(nil, uint32(len(s)+1))
+	value = append(value, s...)
+	return append(value, 0x00)
+}
+
+func TestWrapperBSONInterfaces(t *testing.T) {
+	type role any
+
+	assert.Implements(t, (*bsonValueMarshaler)(nil), enum.WrapEnum[role](0))
+	assert.Implements(t, (*bsonValueMarshaler)(nil), enum.WrapUintEnum[role](0))
+	assert.Implements(t, (*bsonValueMarshaler)(nil), enum.WrapFloatEnum[role](0))
+	assert.Implements(t, (*bsonValueMarshaler)(nil), enum.SafeEnum[role]{})
+
+	assert.Implements(t, (*bsonValueUnmarshaler)(nil), new(enum.WrapEnum[role]))
+	assert.Implements(t, (*bsonValueUnmarshaler)(nil), new(enum.WrapUintEnum[role]))
+	assert.Implements(t, (*bsonValueUnmarshaler)(nil), new(enum.WrapFloatEnum[role]))
+	assert.Implements(t, (*bsonValueUnmarshaler)(nil), new(enum.SafeEnum[role]))
+}
+
+func TestWrapEnumBSONRoundTrip(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		_         = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	typ, value, err := RoleAdmin.MarshalBSONValue()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x02), typ)
+
+	doc := bsonDocument(
+		bsonElement("name", 0x02, bsonString("cleanup")),
+		bsonElement("role", typ, value),
+	)
+
+	// {"name": "cleanup", "role": "admin"}
+	assert.Equal(t, []byte{
+		0x27, 0x00, 0x00, 0x00,
+		0x02, 'n', 'a', 'm', 'e', 0x00, 0x08, 0x00, 0x00, 0x00, 'c', 'l', 'e', 'a', 'n', 'u', 'p', 0x00,
+		0x02, 'r', 'o', 'l', 'e', 0x00, 0x06, 0x00, 0x00, 0x00, 'a', 'd', 'm', 'i', 'n', 0x00,
+		0x00,
+	}, doc)
+
+	var decoded Role
+	typ, value = bsonLookup(t, doc, "role")
+	assert.NoError(t, decoded.UnmarshalBSONValue(typ, value))
+	assert.Equal(t, RoleAdmin, decoded)
+
+	_, _, err = Role(42).MarshalBSONValue()
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: invalid value")
+}
+
+func TestSafeEnumBSONLegacyNumbers(t *testing.T) {
+	type role any
+	type Role = enum.SafeEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	doc := bsonDocument(
+		bsonElement("old", 0x10, binary.LittleEndian.AppendUint32(nil, 1)),
+		bsonElement("older", 0x12, binary.LittleEndian.AppendUint64(nil, 0)),
+	)
+
+	var decoded Role
+	assert.NoError(t, decoded.UnmarshalBSONValue(bsonLookup(t, doc, "old")))
+	assert.Equal(t, RoleAdmin, decoded)
+
+	assert.NoError(t, decoded.UnmarshalBSONValue(bsonLookup(t, doc, "older")))
+	assert.Equal(t, RoleUser, decoded)
+
+	typ, value, err := RoleAdmin.MarshalBSONValue()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x02), typ)
+	assert.Equal(t, bsonString("admin"), value)
+}
+
+func TestUnmarshalBSONValueErrors(t *testing.T) {
+	type Role int
+
+	enum.New[Role]("user")
+
+	var decoded Role
+	assert.EqualError(t, enum.UnmarshalBSONValue(0x02, bsonString("moderator"), &decoded),
+		"enum Role: unknown string moderator")
+	assert.EqualError(t, enum.UnmarshalBSONValue(0x10, binary.LittleEndian.AppendUint32(nil, 42), &decoded),
+		"enum Role: unknown number 42")
+	assert.EqualError(t, enum.UnmarshalBSONValue(0x08, []byte{0x01}, &decoded),
+		"enum Role: not support bson type 0x08")
+	assert.EqualError(t, enum.UnmarshalBSONValue(0x02, []byte{0x01, 0x00}, &decoded),
+		"enum Role: invalid bson value")
+	assert.EqualError(t, enum.UnmarshalBSONValue(0x12, []byte{0x01, 0x00}, &decoded),
+		"enum Role: invalid bson value")
+}
@@ -0,0 +1,132 @@
+package testing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestSafeEnumCBORRoundTrip(t *testing.T) {
+	type role any
+	type Role = enum.SafeEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	type Device struct {
+		Name  string
+		Role  Role
+		Roles []Role
+	}
+
+	device := Device{Name: "sensor", Role: RoleAdmin, Roles: []Role{RoleUser, RoleAdmin}}
+
+	data, err := cbor.Marshal(device)
+	assert.NoError(t, err)
+
+	var generic map[string]any
+	assert.NoError(t, cbor.Unmarshal(data, &generic))
+	assert.Equal(t, "admin", generic["Role"])
+	assert.Equal(t, []any{"user", "admin"}, generic["Roles"])
+
+	var decoded Device
+	assert.NoError(t, cbor.Unmarshal(data, &decoded))
+	assert.Equal(t, device, decoded)
+
+	_, err = cbor.Marshal(Device{})
+	assert.ErrorContains(t, err, "enum SafeEnum[role]: invalid value")
+}
+
+func TestWrapperCBOR(t *testing.T) {
+	type role any
+
+	var (
+		r1 = enum.New[enum.WrapEnum[role]]("user")
+		r2 = enum.New[enum.WrapUintEnum[role]]("user")
+		r3 = enum.New[enum.WrapFloatEnum[role]]("user")
+	)
+
+	for _, v := range []any{r1, r2, r3} {
+		data, err := cbor.Marshal(v)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0x64, 'u', 's', 'e', 'r'}, data)
+	}
+
+	var d1 enum.WrapEnum[role]
+	var d2 enum.WrapUintEnum[role]
+	var d3 enum.WrapFloatEnum[role]
+	assert.NoError(t, cbor.Unmarshal([]byte{0x64, 'u', 's', 'e', 'r'}, &d1))
+	assert.NoError(t, cbor.Unmarshal([]byte{0x64, 'u', 's', 'e', 'r'}, &d2))
+	assert.NoError(t, cbor.Unmarshal([]byte{0x64, 'u', 's', 'e', 'r'}, &d3))
+	assert.Equal(t, r1, d1)
+	assert.Equal(t, r2, d2)
+	assert.Equal(t, r3, d3)
+}
+
+func TestUnmarshalCBORUnknownString(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	enum.New[Role]("user")
+
+	data, err := cbor.Marshal("moderator")
+	assert.NoError(t, err)
+
+	var r Role
+	err = cbor.Unmarshal(data, &r)
+	assert.EqualError(t, err, "enum WrapEnum[role]: unknown string moderator")
+
+	var unknown *enum.UnknownStringError
+	if assert.True(t, errors.As(err, &unknown)) {
+		assert.Equal(t, "WrapEnum[role]", unknown.Type)
+		assert.Equal(t, "moderator", unknown.Input)
+	}
+
+	long := make([]byte, 300)
+	for i := range long {
+		long[i] = 'a'
+	}
+
+	data, err = cbor.Marshal(string(long))
+	assert.NoError(t, err)
+	assert.ErrorAs(t, enum.UnmarshalCBOR(data, &r), &unknown)
+	assert.Equal(t, string(long), unknown.Input)
+}
+
+func TestUnmarshalCBORNumbers(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.Map(Role(-30), "user")
+		RoleAdmin = enum.Map(Role(1000), "admin")
+	)
+
+	var r Role
+	assert.EqualError(t, enum.UnmarshalCBOR([]byte{0x01}, &r), "enum Role: not support cbor major type 0")
+
+	enum.AcceptLegacyNumbers[Role](true)
+	defer enum.AcceptLegacyNumbers[Role](false)
+
+	data, err := cbor.Marshal(1000)
+	assert.NoError(t, err)
+	assert.NoError(t, enum.UnmarshalCBOR(data, &r))
+	assert.Equal(t, RoleAdmin, r)
+
+	data, err = cbor.Marshal(-30)
+	assert.NoError(t, err)
+	assert.NoError(t, enum.UnmarshalCBOR(data, &r))
+	assert.Equal(t, RoleUser, r)
+
+	data, err = cbor.Marshal(42)
+	assert.NoError(t, err)
+	assert.EqualError(t, enum.UnmarshalCBOR(data, &r), "enum Role: unknown number 42")
+
+	assert.EqualError(t, enum.UnmarshalCBOR([]byte{0xf4}, &r), "enum Role: not support cbor major type 7")
+	assert.EqualError(t, enum.UnmarshalCBOR([]byte{0x64, 'u'}, &r), "enum Role: invalid cbor data item")
+	assert.EqualError(t, enum.UnmarshalCBOR(nil, &r), "enum Role: invalid cbor data item")
+}
@@ -0,0 +1,110 @@
+package testing_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestCompare(t *testing.T) {
+	type Status int
+
+	var (
+		StatusDone   = enum.Map(Status(20), "done")
+		StatusDraft  = enum.Map(Status(0), "draft")
+		StatusReview = enum.Map(Status(5), "review")
+	)
+
+	assert.Equal(t, -1, enum.Compare(StatusDraft, StatusReview))
+	assert.Equal(t, 1, enum.Compare(StatusDone, StatusReview))
+	assert.Equal(t, 0, enum.Compare(StatusDone, StatusDone))
+	assert.True(t, enum.Less(StatusDraft, StatusDone))
+	assert.False(t, enum.Less(StatusDone, StatusDone))
+
+	statuses := []Status{Status(42), StatusReview, StatusDone, StatusDraft}
+	slices.SortFunc(statuses, enum.Compare)
+	assert.Equal(t, []Status{StatusDraft, StatusReview, StatusDone, Status(42)}, statuses)
+}
+
+func TestCompareSafeEnum(t *testing.T) {
+	type role string
+	type Role = enum.SafeEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleMod   = enum.New[Role]("mod")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	roles := []Role{RoleAdmin, Role{}, RoleUser, RoleMod}
+	slices.SortFunc(roles, enum.Compare)
+	assert.Equal(t, []Role{RoleUser, RoleMod, RoleAdmin, {}}, roles)
+
+	roles = []Role{RoleMod, RoleAdmin, RoleUser}
+	slices.SortFunc(roles, Role.Compare)
+	assert.Equal(t, []Role{RoleUser, RoleMod, RoleAdmin}, roles)
+	assert.True(t, RoleUser.Less(RoleAdmin))
+}
+
+func TestCompareWrapEnum(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleAdmin = enum.New[Role]("admin", 3)
+		RoleUser  = enum.New[Role]("user", 1)
+		RoleMod   = enum.New[Role]("mod", 2)
+	)
+
+	roles := []Role{RoleMod, RoleAdmin, RoleUser}
+	slices.SortFunc(roles, Role.Compare)
+	assert.Equal(t, []Role{RoleUser, RoleMod, RoleAdmin}, roles)
+	assert.True(t, RoleMod.Less(RoleAdmin))
+	assert.False(t, RoleAdmin.Less(RoleUser))
+}
+
+func TestClamp(t *testing.T) {
+	type Severity int
+
+	var (
+		SeverityLow    = enum.Map(Severity(1), "low")
+		SeverityHigh   = enum.Map(Severity(10), "high")
+		SeverityMedium = enum.Map(Severity(5), "medium")
+	)
+
+	assert.Equal(t, SeverityLow, enum.Clamp[Severity](-3))
+	assert.Equal(t, SeverityHigh, enum.Clamp[Severity](uint64(99)))
+	assert.Equal(t, SeverityMedium, enum.Clamp[Severity](5))
+	assert.Equal(t, SeverityMedium, enum.Clamp[Severity](6.9))
+	assert.Equal(t, SeverityHigh, enum.Clamp[Severity](8))
+	assert.Equal(t, SeverityLow, enum.Clamp[Severity](3), "ties toward the lower value")
+	assert.Equal(t, SeverityMedium, enum.Clamp[Severity](7.5), "ties toward the lower value")
+}
+
+func TestClampFloat(t *testing.T) {
+	type Constant float64
+
+	var (
+		ConstantX  = enum.New[Constant]("x", 1.13)
+		ConstantE  = enum.New[Constant]("e", 2.72)
+		ConstantPi = enum.New[Constant]("pi", 3.14)
+	)
+
+	assert.Equal(t, ConstantX, enum.Clamp[Constant](float32(0.5)))
+	assert.Equal(t, ConstantE, enum.Clamp[Constant](2.72))
+	assert.Equal(t, ConstantE, enum.Clamp[Constant](2.9))
+	assert.Equal(t, ConstantPi, enum.Clamp[Constant](3))
+	assert.Equal(t, ConstantPi, enum.Clamp[Constant](100))
+}
+
+func TestClampOr(t *testing.T) {
+	type Severity int
+
+	assert.Equal(t, Severity(0), enum.Clamp[Severity](3))
+	assert.Equal(t, Severity(-1), enum.ClampOr(3, Severity(-1)))
+
+	SeverityLow := enum.Map(Severity(1), "low")
+	assert.Equal(t, SeverityLow, enum.ClampOr(3, Severity(-1)))
+}
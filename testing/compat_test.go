@@ -0,0 +1,141 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+type compatRole int
+
+var (
+	_ = enum.Map(compatRole(0), "user")
+	_ = enum.Map(compatRole(1), "admin")
+	_ = enum.Map(compatRole(2), "guest")
+)
+
+type compatCode int
+
+var (
+	_ = enum.MapPair(enum.Map(compatCode(0), "quota"), enum.Pair(4, 12))
+	_ = enum.Map(compatCode(1), "timeout")
+)
+
+const compatOldExport = `{
+  "version": 1,
+  "types": [
+    {
+      "id": "github.com/xybor-x/enum/testing_test.compatRole",
+      "name": "compatRole",
+      "values": [
+        {"string": "member", "number": 0},
+        {"string": "owner", "number": 3},
+        {"string": "admin", "number": 5}
+      ],
+      "pairFormat": true
+    },
+    {
+      "id": "github.com/xybor-x/enum/testing_test.compatCode",
+      "name": "compatCode",
+      "values": [
+        {"string": "quota", "number": 0, "pair": "4.11"},
+        {"string": "timeout", "number": 1}
+      ]
+    },
+    {
+      "id": "github.com/example/app.Gone",
+      "name": "Gone",
+      "values": [{"string": "x", "number": 0}]
+    }
+  ]
+}`
+
+func TestExportRegistry(t *testing.T) {
+	data, err := enum.ExportRegistry()
+	assert.NoError(t, err)
+
+	again, err := enum.ExportRegistry()
+	assert.NoError(t, err)
+	assert.Equal(t, data, again)
+
+	var export struct {
+		Version int               `json:"version"`
+		Types   []json.RawMessage `json:"types"`
+	}
+	assert.NoError(t, json.Unmarshal(data, &export))
+	assert.Equal(t, 1, export.Version)
+
+	found := false
+	for _, typ := range export.Types {
+		var header struct{ ID string }
+		assert.NoError(t, json.Unmarshal(typ, &header))
+
+		if header.ID == "github.com/xybor-x/enum/testing_test.compatCode" {
+			found = true
+			assert.JSONEq(t, `{
+				"id": "github.com/xybor-x/enum/testing_test.compatCode",
+				"name": "compatCode",
+				"values": [
+					{"string": "quota", "number": 0, "pair": "4.12"},
+					{"string": "timeout", "number": 1}
+				]
+			}`, string(typ))
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestCompatCheckClean(t *testing.T) {
+	data, err := enum.ExportRegistry()
+	assert.NoError(t, err)
+
+	all := enum.CompatPolicy{Breaking: ^enum.ChangeKind(0)}
+	assert.Empty(t, enum.CompatCheck(data, all))
+}
+
+func TestCompatCheckViolations(t *testing.T) {
+	violations := enum.CompatCheck([]byte(compatOldExport), enum.DefaultCompatPolicy())
+	assert.Equal(t, []enum.Violation{
+		{Kind: enum.ChangeRename, Type: "compatRole", Value: "member",
+			Message: "enum compatRole: value member (0) was renamed to user"},
+		{Kind: enum.ChangeRemoval, Type: "compatRole", Value: "owner",
+			Message: "enum compatRole: value owner (3) was removed"},
+		{Kind: enum.ChangeRenumbering, Type: "compatRole", Value: "admin",
+			Message: "enum compatRole: value admin was renumbered from 5 to 1"},
+		{Kind: enum.ChangeFormat, Type: "compatRole",
+			Message: "enum compatRole: pair format was disabled"},
+		{Kind: enum.ChangeRenumbering, Type: "compatCode", Value: "quota",
+			Message: "enum compatCode: pair of value quota changed from 4.11 to 4.12"},
+		{Kind: enum.ChangeRemoval, Type: "Gone",
+			Message: "enum Gone: the type was removed"},
+	}, violations)
+}
+
+func TestCompatCheckPolicy(t *testing.T) {
+	violations := enum.CompatCheck([]byte(compatOldExport), enum.CompatPolicy{Breaking: enum.ChangeAddition})
+	assert.Equal(t, []enum.Violation{
+		{Kind: enum.ChangeAddition, Type: "compatRole", Value: "guest",
+			Message: "enum compatRole: value guest (2) was added"},
+	}, violations)
+
+	violations = enum.CompatCheck([]byte(compatOldExport), enum.CompatPolicy{Breaking: enum.ChangeRename})
+	if assert.Len(t, violations, 1) {
+		assert.EqualError(t, violations[0], "enum compatRole: value member (0) was renamed to user")
+	}
+
+	assert.Empty(t, enum.CompatCheck([]byte(compatOldExport), enum.CompatPolicy{}))
+}
+
+func TestCompatCheckInvalidExport(t *testing.T) {
+	assert.Equal(t, []enum.Violation{
+		{Message: "invalid registry export: unsupported version 2"},
+	}, enum.CompatCheck([]byte(`{"version": 2, "types": []}`), enum.DefaultCompatPolicy()))
+
+	violations := enum.CompatCheck([]byte(`{`), enum.DefaultCompatPolicy())
+	if assert.Len(t, violations, 1) {
+		assert.Zero(t, violations[0].Kind)
+		assert.Contains(t, violations[0].Message, "invalid registry export: ")
+	}
+}
@@ -0,0 +1,10 @@
+// Package crosspkg registers enum values from a package other than the tests,
+// to simulate an enum type shared by many packages.
+package crosspkg
+
+import "github.com/xybor-x/enum"
+
+// New creates an enum value from this package.
+func New[Enum any](reprs ...any) Enum {
+	return enum.New[Enum](reprs...)
+}
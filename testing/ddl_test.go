@@ -0,0 +1,82 @@
+package testing_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestSQLCreateType(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	enum.New[Role]("user")
+	enum.New[Role]("admin")
+	enum.New[Role]("o'brien")
+
+	ddl, err := enum.SQLCreateType[Role]("role_type", enum.PostgresDialect)
+	assert.NoError(t, err)
+	assert.Equal(t, "CREATE TYPE role_type AS ENUM ('user', 'admin', 'o''brien')", ddl)
+
+	_, err = enum.SQLCreateType[Role]("role_type", enum.MySQLDialect)
+	assert.EqualError(t, err, "enum WrapEnum[role]: mysql doesn't support enum types, use a check constraint")
+}
+
+func TestSQLCheckConstraint(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type level any
+	type Level = enum.WrapEnum[level]
+
+	enum.New[Role]("user")
+	enum.New[Role]("admin")
+	enum.New[Role](`o'brien\x`)
+
+	enum.New[Level]("low", 1)
+	enum.New[Level]("high", 10)
+	enum.SetSQLFormat[Level](enum.SQLNumber)
+
+	assert.Equal(t, `CHECK (role IN ('user', 'admin', 'o''brien\x'))`,
+		enum.SQLCheckConstraint[Role]("role", enum.PostgresDialect))
+	assert.Equal(t, `CHECK (role IN ('user', 'admin', 'o''brien\\x'))`,
+		enum.SQLCheckConstraint[Role]("role", enum.MySQLDialect))
+	assert.Equal(t, `CHECK (role IN ('user', 'admin', 'o''brien\x'))`,
+		enum.SQLCheckConstraint[Role]("role", enum.SQLiteDialect))
+	assert.Equal(t, `CHECK (level IN (1, 10))`,
+		enum.SQLCheckConstraint[Level]("level", enum.SQLiteDialect))
+
+
+	// Large and fractional numbers are written without exponent.
+	type code any
+	type Code = enum.WrapEnum[code]
+	type ratio any
+	type Ratio = enum.WrapFloatEnum[ratio]
+
+	enum.New[Code]("ok", 1000000)
+	enum.New[Code]("moved", 4012000)
+	enum.SetSQLFormat[Code](enum.SQLNumber)
+
+	enum.New[Ratio]("tiny", 0.0000005)
+	enum.New[Ratio]("huge", 2500000.5)
+	enum.SetSQLFormat[Ratio](enum.SQLNumber)
+
+	assert.Equal(t, `CHECK (code IN (1000000, 4012000))`,
+		enum.SQLCheckConstraint[Code]("code", enum.PostgresDialect))
+	assert.Equal(t, `CHECK (ratio IN (0.0000005, 2500000.5))`,
+		enum.SQLCheckConstraint[Ratio]("ratio", enum.PostgresDialect))
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE accounts (role TEXT ` + enum.SQLCheckConstraint[Role]("role", enum.SQLiteDialect) + `)`)
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`INSERT INTO accounts VALUES (?)`, enum.MustFromString[Role](`o'brien\x`))
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`INSERT INTO accounts VALUES ('guest')`)
+	assert.ErrorContains(t, err, "CHECK constraint failed")
+}
@@ -0,0 +1,81 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestNewDecoderRequiresFinalized(t *testing.T) {
+	type decoderLevel int
+
+	enum.New[decoderLevel]("debug")
+
+	d, err := enum.NewDecoder[decoderLevel]()
+	assert.Nil(t, d)
+	assert.EqualError(t, err, "enum decoderLevel: a decoder requires the enum to be finalized")
+
+	enum.Finalize[decoderLevel]()
+
+	d, err = enum.NewDecoder[decoderLevel]()
+	assert.NoError(t, err)
+	assert.NotNil(t, d)
+}
+
+func TestDecoderFromString(t *testing.T) {
+	type decoderStatus int
+
+	var (
+		// "ab", "ba" and the unknown "aa", "bb" share a cache slot.
+		StatusAB    = enum.New[decoderStatus]("ab")
+		StatusBA    = enum.New[decoderStatus]("ba")
+		StatusOK    = enum.New[decoderStatus]("ok")
+		StatusError = enum.New[decoderStatus]("error")
+	)
+
+	enum.Finalize[decoderStatus]()
+
+	d, err := enum.NewDecoder[decoderStatus]()
+	assert.NoError(t, err)
+
+	inputs := []string{
+		"ab", "ba", "ab", "ab", "aa", "ba", "bb", "ok", "error", "ok", "", "ab", "aa", "error", "ba", "",
+	}
+
+	expected := map[string]decoderStatus{
+		"ab": StatusAB, "ba": StatusBA, "ok": StatusOK, "error": StatusError,
+	}
+
+	for _, input := range inputs {
+		value, ok := d.FromString(input)
+		want, wantOK := expected[input]
+
+		assert.Equal(t, wantOK, ok, input)
+		assert.Equal(t, want, value, input)
+	}
+}
+
+func TestDecoderDoesNotAliasInput(t *testing.T) {
+	type decoderColor int
+
+	ColorRed := enum.New[decoderColor]("red")
+	enum.Finalize[decoderColor]()
+
+	d, err := enum.NewDecoder[decoderColor]()
+	assert.NoError(t, err)
+
+	buf := []byte("red")
+	value, ok := d.FromString(string(buf))
+	assert.True(t, ok)
+	assert.Equal(t, ColorRed, value)
+
+	// The decoder must not observe a reused input buffer.
+	copy(buf, "rad")
+	_, ok = d.FromString(string(buf))
+	assert.False(t, ok)
+
+	value, ok = d.FromString("red")
+	assert.True(t, ok)
+	assert.Equal(t, ColorRed, value)
+}
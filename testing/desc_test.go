@@ -0,0 +1,123 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDescOf(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.MapDesc(enum.New[Role]("admin"), "full administrative access")
+	)
+
+	assert.Equal(t, "", enum.DescOf(RoleUser))
+	assert.Equal(t, "full administrative access", enum.DescOf(RoleAdmin))
+	assert.Equal(t, "", enum.DescOf(Role(42)))
+
+	assert.PanicsWithValue(t, "enum Role (42): cannot describe an invalid value", func() {
+		enum.MapDesc(Role(42), "unknown")
+	})
+}
+
+func TestDescribedValues(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.MapDesc(enum.New[Role]("admin"), "full administrative access")
+	)
+
+	values := enum.DescribedValues[Role]()
+	assert.Equal(t, []enum.DescribedValue[Role]{
+		{Value: RoleUser, Name: "user"},
+		{Value: RoleAdmin, Name: "admin", Desc: "full administrative access"},
+	}, values)
+
+	assert.Equal(t, "user", values[0].String())
+	assert.Equal(t, "admin — full administrative access", values[1].String())
+}
+
+func TestSchemaOf(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser = enum.New[Role]("user")
+		_        = enum.New[Role]("admin")
+	)
+
+	data, err := json.Marshal(enum.SchemaOf[Role]())
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"string","enum":["user","admin"]}`, string(data))
+
+	enum.MapDesc(RoleUser, "regular user")
+
+	data, err = json.Marshal(enum.SchemaOf[Role]())
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"type":"string","enum":["user","admin"],"x-enum-descriptions":["regular user","admin"]}`,
+		string(data))
+}
+
+func TestSchemaOfJSONNumber(t *testing.T) {
+	type Role int
+
+	var (
+		_         = enum.New[Role]("user", 1)
+		RoleAdmin = enum.New[Role]("admin", 10)
+	)
+
+	enum.MapDesc(RoleAdmin, "full administrative access")
+	enum.SetJSONFormat[Role](enum.JSONNumber)
+
+	data, err := json.Marshal(enum.SchemaOf[Role]())
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"type":"integer","enum":[1,10],"x-enum-descriptions":["user","full administrative access"]}`,
+		string(data))
+}
+
+func TestSchemaOfEmpty(t *testing.T) {
+	type Role int
+
+	data, err := json.Marshal(enum.SchemaOf[Role]())
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"string","enum":[]}`, string(data))
+}
+
+func TestErrorValueListing(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		_ = enum.New[Role]("user")
+		_ = enum.MapDesc(enum.New[Role]("admin"), "full administrative access")
+	)
+
+	var r Role
+	assert.EqualError(t, json.Unmarshal([]byte(`"moderator"`), &r),
+		"enum WrapEnum[role]: unknown string moderator")
+
+	enum.SetErrorValueListing[Role](true)
+	assert.EqualError(t, json.Unmarshal([]byte(`"moderator"`), &r),
+		"enum WrapEnum[role]: unknown string moderator (allowed values: user, admin)")
+
+	enum.SetErrorValueListing[Role](true, enum.WithDescriptions())
+	assert.EqualError(t, json.Unmarshal([]byte(`"moderator"`), &r),
+		"enum WrapEnum[role]: unknown string moderator (allowed values: user, admin — full administrative access)")
+	assert.EqualError(t, yaml.Unmarshal([]byte(`moderator`), &r),
+		"enum WrapEnum[role]: unknown string moderator (allowed values: user, admin — full administrative access)")
+	assert.EqualError(t, r.Scan("moderator"),
+		"enum WrapEnum[role]: unknown string moderator (allowed values: user, admin — full administrative access)")
+
+	enum.SetErrorValueListing[Role](false)
+	assert.EqualError(t, r.Scan("moderator"), "enum WrapEnum[role]: unknown string moderator")
+
+}
@@ -0,0 +1,94 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+type dryRunHex string
+
+func TestDryRunMapConflicts(t *testing.T) {
+	type dryRunColor int
+
+	var (
+		ColorRed   = enum.Map(dryRunColor(0), "red", dryRunHex("#f00"))
+		ColorGreen = enum.Map(dryRunColor(1), "green")
+	)
+
+	conflicts := enum.DryRunMap([]enum.Def[dryRunColor]{
+		{Value: 2, Reprs: []any{"red"}},
+		{Value: 1, Reprs: []any{"blue"}},
+		{Value: 3, Reprs: []any{"yellow"}},
+		{Value: 4, Reprs: []any{"yellow"}},
+		{Value: 5, Reprs: []any{"purple", dryRunHex("#f00")}},
+		{Value: 6},
+		{Value: 7, Reprs: []any{"cyan", "magenta"}},
+	})
+
+	assert.Equal(t, []enum.Conflict{
+		{Value: dryRunColor(2), Existing: ColorRed,
+			Reason: "enum dryRunColor (2): string red was already mapped to 0"},
+		{Value: dryRunColor(1), Existing: ColorGreen,
+			Reason: "enum dryRunColor (1): do not map number twice"},
+		{Value: dryRunColor(1), Existing: ColorGreen,
+			Reason: "enum dryRunColor (1): do not map string twice"},
+		{Value: dryRunColor(4), Existing: dryRunColor(3),
+			Reason: "enum dryRunColor (4): string yellow was already mapped to 3"},
+		{Value: dryRunColor(5), Existing: ColorRed,
+			Reason: "enum dryRunColor (5): representation #f00 of testing_test.dryRunHex was already mapped to 0"},
+		{Value: dryRunColor(6),
+			Reason: "enum dryRunColor (6): not found any string representation"},
+		{Value: dryRunColor(7),
+			Reason: "enum dryRunColor (7): multiple primitive strings are provided (cyan, magenta)"},
+	}, conflicts)
+
+	// The registry is untouched.
+	assert.Equal(t, []dryRunColor{ColorRed, ColorGreen}, enum.All[dryRunColor]())
+	assert.False(t, enum.IsValid(dryRunColor(3)))
+	_, ok := enum.FromString[dryRunColor]("yellow")
+	assert.False(t, ok)
+	_, ok = enum.FromNumber[dryRunColor](2)
+	assert.False(t, ok)
+
+	// The candidates without conflicts can still be mapped.
+	assert.NotPanics(t, func() { enum.Map(dryRunColor(3), "yellow") })
+	assert.NotPanics(t, func() { enum.Map(dryRunColor(2), "blue") })
+}
+
+func TestDryRunMapMatchesPanic(t *testing.T) {
+	type dryRunSize int
+
+	enum.Map(dryRunSize(0), "small")
+
+	conflicts := enum.DryRunMap([]enum.Def[dryRunSize]{{Value: 1, Reprs: []any{"small"}}})
+	if assert.Len(t, conflicts, 1) {
+		assert.PanicsWithValue(t, conflicts[0].Reason, func() { enum.Map(dryRunSize(1), "small") })
+	}
+}
+
+func TestDryRunMapFinalized(t *testing.T) {
+	type dryRunShape int
+
+	enum.Map(dryRunShape(0), "circle")
+	enum.Finalize[dryRunShape]()
+
+	conflicts := enum.DryRunMap([]enum.Def[dryRunShape]{{Value: 1, Reprs: []any{"square"}}})
+	assert.Equal(t, []enum.Conflict{
+		{Value: dryRunShape(1), Reason: "enum dryRunShape: the enum was already finalized"},
+	}, conflicts)
+	assert.EqualError(t, conflicts[0], "enum dryRunShape: the enum was already finalized")
+}
+
+func TestDryRunMapNoConflict(t *testing.T) {
+	type dryRunTaste int
+
+	enum.Map(dryRunTaste(0), "sweet")
+
+	assert.Empty(t, enum.DryRunMap([]enum.Def[dryRunTaste]{
+		{Value: 1, Reprs: []any{"sour"}},
+		{Value: 2, Reprs: []any{"bitter"}},
+	}))
+	assert.Len(t, enum.All[dryRunTaste](), 1)
+}
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/xybor-x/enum"
+	"github.com/xybor-x/enum/testing/crosspkg"
 
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -107,7 +110,7 @@ func TestEnumMapDuplicated(t *testing.T) {
 
 	assert.Equal(t, enum.ToString(RoleUser), "user")
 	assert.PanicsWithValue(t,
-		"enum Role (0): do not map number twice",
+		"enum Role (0): number 0 was auto-assigned to user by enum.New; pass an explicit number or reorder registrations",
 		func() { enum.Map(RoleUser, "admin") },
 	)
 	assert.PanicsWithValue(t,
@@ -131,6 +134,120 @@ func TestEnumMustToString(t *testing.T) {
 	assert.Equal(t, enum.ToString(Role(42)), "<nil>")
 }
 
+func TestEnumToStringOr(t *testing.T) {
+	type Role int
+
+	RoleUser := enum.New[Role]("user")
+
+	assert.Equal(t, "user", enum.ToStringOr(RoleUser, "unknown"))
+	assert.Equal(t, "unknown", enum.ToStringOr(Role(42), "unknown"))
+	assert.Equal(t, "", enum.ToStringOr(Role(42), ""))
+
+	itoa := func(r Role) string { return "Role(" + strconv.Itoa(int(r)) + ")" }
+	assert.Equal(t, "user", enum.ToStringOrElse(RoleUser, itoa))
+	assert.Equal(t, "Role(42)", enum.ToStringOrElse(Role(42), itoa))
+}
+
+func TestEnumToSafe(t *testing.T) {
+	type Role int
+
+	RoleUser := enum.New[Role]("user")
+
+	s, err := enum.ToStringSafe(RoleUser)
+	assert.NoError(t, err)
+	assert.Equal(t, "user", s)
+
+	_, err = enum.ToStringSafe(Role(42))
+	assert.EqualError(t, err, "enum Role: invalid value 42")
+
+	_, marshalErr := enum.MarshalJSON(Role(42))
+	assert.EqualError(t, marshalErr, err.Error())
+
+	n, err := enum.ToSafe[int](RoleUser)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	_, err = enum.ToSafe[int](Role(42))
+	assert.EqualError(t, err, "enum Role: invalid value 42")
+
+	_, err = enum.ToSafe[bool](RoleUser)
+	assert.EqualError(t, err, "enum Role (0): no representation of type bool")
+}
+
+func TestEnumToNumber(t *testing.T) {
+	type Role int
+
+	RoleAdmin := enum.Map(Role(7), "admin")
+
+	assert.Equal(t, int8(7), enum.MustToNumber[int8](RoleAdmin))
+	assert.Equal(t, int16(7), enum.MustToNumber[int16](RoleAdmin))
+	assert.Equal(t, int32(7), enum.MustToNumber[int32](RoleAdmin))
+	assert.Equal(t, int64(7), enum.MustToNumber[int64](RoleAdmin))
+	assert.Equal(t, 7, enum.MustToNumber[int](RoleAdmin))
+	assert.Equal(t, uint8(7), enum.MustToNumber[uint8](RoleAdmin))
+	assert.Equal(t, uint16(7), enum.MustToNumber[uint16](RoleAdmin))
+	assert.Equal(t, uint32(7), enum.MustToNumber[uint32](RoleAdmin))
+	assert.Equal(t, uint64(7), enum.MustToNumber[uint64](RoleAdmin))
+	assert.Equal(t, uint(7), enum.MustToNumber[uint](RoleAdmin))
+	assert.Equal(t, float32(7), enum.MustToNumber[float32](RoleAdmin))
+	assert.Equal(t, float64(7), enum.MustToNumber[float64](RoleAdmin))
+
+	_, ok := enum.ToNumber[int](Role(42))
+	assert.False(t, ok)
+	assert.Equal(t, 0, enum.MustToNumber[int](Role(42)))
+}
+
+func TestEnumToNumberFloat(t *testing.T) {
+	type Ratio float64
+
+	var (
+		RatioHalf = enum.New[Ratio]("half", 0.5)
+		RatioOne  = enum.New[Ratio]("one", 1.0)
+	)
+
+	f, ok := enum.ToNumber[float64](RatioHalf)
+	assert.True(t, ok)
+	assert.Equal(t, 0.5, f)
+	assert.Equal(t, float32(0.5), enum.MustToNumber[float32](RatioHalf))
+
+	_, ok = enum.ToNumber[int](RatioHalf)
+	assert.False(t, ok)
+	_, ok = enum.ToNumber[uint8](RatioHalf)
+	assert.False(t, ok)
+
+	n, ok := enum.ToNumber[int](RatioOne)
+	assert.True(t, ok)
+	assert.Equal(t, 1, n)
+}
+
+func TestEnumBytes(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	b, ok := enum.To[[]byte](RoleUser)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("user"), b)
+	assert.Equal(t, []byte("admin"), enum.MustTo[[]byte](RoleAdmin))
+
+	_, ok = enum.To[[]byte](Role(42))
+	assert.False(t, ok)
+
+	role, ok := enum.From[Role]([]byte("user"))
+	assert.True(t, ok)
+	assert.Equal(t, RoleUser, role)
+
+	_, ok = enum.From[Role]([]byte("moderator"))
+	assert.False(t, ok)
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		enum.To[[]byte](RoleAdmin)
+	}))
+}
+
 func TestEnumFromString(t *testing.T) {
 	type Role int
 
@@ -159,6 +276,61 @@ func TestEnumMustFromString(t *testing.T) {
 	assert.Equal(t, enum.MustFromString[Role]("admin"), RoleAdmin)
 }
 
+func TestEnumFromStringOr(t *testing.T) {
+	type Role int
+
+	RoleGuest := Role(-1)
+	assert.Equal(t, RoleGuest, enum.FromStringOr("user", RoleGuest), "unregistered type")
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	tests := []struct {
+		input string
+		want  Role
+	}{
+		{"user", RoleUser},
+		{"admin", RoleAdmin},
+		{"moderator", RoleGuest},
+		{"", RoleGuest},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, enum.FromStringOr(tt.input, RoleGuest), tt.input)
+	}
+}
+
+func TestEnumFromNumberOr(t *testing.T) {
+	type Role int
+
+	RoleGuest := Role(-1)
+	assert.Equal(t, RoleGuest, enum.FromNumberOr(0, RoleGuest), "unregistered type")
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	tests := []struct {
+		input int
+		want  Role
+	}{
+		{0, RoleUser},
+		{1, RoleAdmin},
+		{42, RoleGuest},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, enum.FromNumberOr(tt.input, RoleGuest), tt.input)
+		assert.Equal(t, tt.want, enum.FromOr(tt.input, RoleGuest), tt.input)
+	}
+
+	assert.Equal(t, RoleAdmin, enum.FromOr("admin", RoleGuest))
+	assert.Equal(t, RoleGuest, enum.FromOr(1.5, RoleGuest))
+}
+
 func TestEnumFromNumber(t *testing.T) {
 	type Role int
 
@@ -266,6 +438,274 @@ func TestEnumAll(t *testing.T) {
 	assert.Contains(t, all, RoleAdmin)
 }
 
+func TestEnumAllCopy(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	all := enum.All[Role]()
+	all[0] = RoleAdmin
+	_ = append(all[:1], RoleUser)
+	assert.Equal(t, []Role{RoleUser, RoleAdmin}, enum.All[Role]())
+	assert.Equal(t, []Role{RoleUser, RoleAdmin}, enum.AllRef[Role]())
+
+	RoleGuest := enum.New[Role]("guest")
+	assert.Equal(t, []Role{RoleAdmin, RoleUser}, all)
+	assert.Equal(t, []Role{RoleUser, RoleAdmin, RoleGuest}, enum.All[Role]())
+}
+
+func TestEnumAllSorted(t *testing.T) {
+	type Role int
+
+	assert.Empty(t, enum.AllSorted[Role]())
+
+	var (
+		RoleAdmin = enum.Map(Role(2), "admin")
+		RoleUser  = enum.Map(Role(0), "user")
+		RoleGuest = enum.Map(Role(1), "guest")
+	)
+
+	assert.Equal(t, []Role{RoleAdmin, RoleUser, RoleGuest}, enum.All[Role]())
+	assert.Equal(t, []Role{RoleUser, RoleGuest, RoleAdmin}, enum.AllSorted[Role]())
+
+	enum.Finalize[Role]()
+	sorted := enum.AllSorted[Role]()
+	assert.Equal(t, []Role{RoleUser, RoleGuest, RoleAdmin}, sorted)
+
+	sorted[0] = RoleAdmin
+	assert.Equal(t, []Role{RoleUser, RoleGuest, RoleAdmin}, enum.AllSorted[Role]())
+	assert.Equal(t, []Role{RoleAdmin, RoleUser, RoleGuest}, enum.All[Role]())
+}
+
+func TestEnumAllSortedFloat(t *testing.T) {
+	type Constant float64
+
+	var (
+		ConstantPi = enum.New[Constant]("pi", 3.14)
+		ConstantE  = enum.New[Constant]("e", 2.72)
+		ConstantX  = enum.New[Constant]("x", 1.13)
+	)
+
+	assert.Equal(t, []Constant{ConstantPi, ConstantE, ConstantX}, enum.All[Constant]())
+	assert.Equal(t, []Constant{ConstantX, ConstantE, ConstantPi}, enum.AllSorted[Constant]())
+}
+
+func TestEnumMinMax(t *testing.T) {
+	type Role int
+
+	_, ok := enum.Min[Role]()
+	assert.False(t, ok)
+	_, ok = enum.Max[Role]()
+	assert.False(t, ok)
+	assert.Equal(t, Role(0), enum.MustMin[Role]())
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		_         = enum.New[Role]("mod")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	smallest, ok := enum.Min[Role]()
+	assert.True(t, ok)
+	assert.Equal(t, RoleUser, smallest)
+	assert.Equal(t, RoleAdmin, enum.MustMax[Role]())
+}
+
+func TestEnumMinMaxExplicit(t *testing.T) {
+	type Status int
+
+	var (
+		StatusActive  = enum.Map(Status(10), "active")
+		StatusDeleted = enum.Map(Status(-5), "deleted")
+		_             = enum.Map(Status(3), "pending")
+	)
+
+	assert.Equal(t, StatusDeleted, enum.MustMin[Status]())
+	assert.Equal(t, StatusActive, enum.MustMax[Status]())
+}
+
+func TestEnumMinMaxFloat(t *testing.T) {
+	type Constant float64
+
+	var (
+		_          = enum.New[Constant]("e", 2.72)
+		ConstantX  = enum.New[Constant]("x", 2.5)
+		ConstantPi = enum.New[Constant]("pi", 3.14)
+		_          = enum.New[Constant]("y", 2.9)
+	)
+
+	assert.Equal(t, ConstantX, enum.MustMin[Constant]())
+	assert.Equal(t, ConstantPi, enum.MustMax[Constant]())
+}
+
+func TestEnumNextPrev(t *testing.T) {
+	type Status int
+
+	var (
+		StatusDone    = enum.Map(Status(20), "done")
+		StatusDraft   = enum.Map(Status(0), "draft")
+		StatusReview  = enum.Map(Status(5), "review")
+		StatusInvalid = Status(7)
+	)
+
+	next, ok := enum.Next(StatusDraft)
+	assert.True(t, ok)
+	assert.Equal(t, StatusReview, next)
+
+	next, ok = enum.Next(StatusReview)
+	assert.True(t, ok)
+	assert.Equal(t, StatusDone, next)
+
+	_, ok = enum.Next(StatusDone)
+	assert.False(t, ok)
+
+	prev, ok := enum.Prev(StatusDone)
+	assert.True(t, ok)
+	assert.Equal(t, StatusReview, prev)
+
+	_, ok = enum.Prev(StatusDraft)
+	assert.False(t, ok)
+
+	next, ok = enum.NextWrap(StatusDone)
+	assert.True(t, ok)
+	assert.Equal(t, StatusDraft, next)
+
+	prev, ok = enum.PrevWrap(StatusDraft)
+	assert.True(t, ok)
+	assert.Equal(t, StatusDone, prev)
+
+	_, ok = enum.Next(StatusInvalid)
+	assert.False(t, ok)
+	_, ok = enum.PrevWrap(StatusInvalid)
+	assert.False(t, ok)
+}
+
+func TestEnumNextPrevFloat(t *testing.T) {
+	type Constant float64
+
+	var (
+		ConstantPi = enum.New[Constant]("pi", 3.14)
+		ConstantX  = enum.New[Constant]("x", 1.13)
+		ConstantE  = enum.New[Constant]("e", 2.72)
+	)
+
+	next, ok := enum.Next(ConstantX)
+	assert.True(t, ok)
+	assert.Equal(t, ConstantE, next)
+
+	prev, ok := enum.Prev(ConstantPi)
+	assert.True(t, ok)
+	assert.Equal(t, ConstantE, prev)
+
+	next, ok = enum.NextWrap(ConstantPi)
+	assert.True(t, ok)
+	assert.Equal(t, ConstantX, next)
+}
+
+func TestEnumIsValidString(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser = enum.New[Role]("user")
+		_        = enum.NewOpts[Role](enum.Str("admin"), enum.Alias("root"))
+	)
+
+	assert.True(t, enum.IsValidString[Role]("user"))
+	assert.True(t, enum.IsValidString[Role]("root"))
+	assert.False(t, enum.IsValidString[Role]("moderator"))
+	assert.False(t, enum.IsValidString[Role](""))
+
+	assert.True(t, enum.Contains(RoleUser))
+	assert.False(t, enum.Contains(Role(42)))
+}
+
+func TestEnumIsValidNumber(t *testing.T) {
+	type Role int
+	type Constant float64
+
+	var (
+		_ = enum.Map(Role(3), "user")
+		_ = enum.New[Constant]("pi", 3.14)
+	)
+
+	assert.True(t, enum.IsValidNumber[Role](3))
+	assert.True(t, enum.IsValidNumber[Role](int8(3)))
+	assert.False(t, enum.IsValidNumber[Role](4))
+
+	assert.True(t, enum.IsValidNumber[Constant](3.14))
+	assert.False(t, enum.IsValidNumber[Constant](3))
+}
+
+func TestEnumIsValidStringAllocs(t *testing.T) {
+	type Role int
+
+	_ = enum.New[Role]("user")
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		enum.IsValidString[Role]("user")
+		enum.IsValidString[Role]("unknown")
+	}))
+}
+
+func TestEnumAllStrings(t *testing.T) {
+	type Role int
+
+	assert.Empty(t, enum.AllStrings[Role]())
+
+	var (
+		_ = enum.New[Role]("user")
+		_ = enum.NewOpts[Role](enum.Str("admin"), enum.Alias("root"))
+		_ = enum.New[Role]("moderator")
+	)
+
+	strs := enum.AllStrings[Role]()
+	assert.Equal(t, []string{"user", "admin", "moderator"}, strs)
+	for i, role := range enum.All[Role]() {
+		assert.Equal(t, enum.ToString(role), strs[i])
+	}
+
+	strs[0] = "corrupted"
+	assert.Equal(t, []string{"user", "admin", "moderator"}, enum.AllStrings[Role]())
+}
+
+func TestEnumPairs(t *testing.T) {
+	type Role int
+
+	assert.Empty(t, enum.Pairs[Role]())
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	pairs := enum.Pairs[Role]()
+	assert.Equal(t, []enum.NamedValue[Role]{{Value: RoleUser, Name: "user"}, {Value: RoleAdmin, Name: "admin"}}, pairs)
+	for i, role := range enum.All[Role]() {
+		assert.Equal(t, enum.ToString(role), pairs[i].Name)
+	}
+}
+
+func TestEnumCount(t *testing.T) {
+	type Role int
+
+	assert.Equal(t, 0, enum.Count[Role]())
+
+	var (
+		_ = enum.New[Role]("user")
+		_ = enum.NewOpts[Role](enum.Str("admin"), enum.Alias("root"))
+	)
+
+	assert.Equal(t, 2, enum.Count[Role]())
+	assert.Equal(t, len(enum.All[Role]()), enum.Count[Role]())
+
+	enum.Finalize[Role]()
+	assert.Equal(t, 2, enum.Count[Role]())
+	assert.Equal(t, len(enum.All[Role]()), enum.Count[Role]())
+}
+
 func TestEnumByte(t *testing.T) {
 	type Role byte
 
@@ -589,6 +1029,91 @@ func TestNewExtended(t *testing.T) {
 	assert.Equal(t, []Role{RoleUser, RoleAdmin}, enum.All[Role]())
 }
 
+func TestNewExtendedNested(t *testing.T) {
+	type role any
+	type Base struct{ enum.SafeEnum[role] }
+	type Role struct{ Base }
+
+	var (
+		RoleUser  = enum.NewExtended[Role]("user")
+		RoleAdmin = enum.NewExtended[Role]("admin")
+	)
+
+	assert.Equal(t, "user", RoleUser.String())
+	assert.Equal(t, "admin", RoleAdmin.String())
+	assert.Equal(t, RoleAdmin, enum.MustFromString[Role]("admin"))
+	assert.Equal(t, []Role{RoleUser, RoleAdmin}, enum.All[Role]())
+}
+
+func TestNewExtendedPointer(t *testing.T) {
+	type role any
+	type Role struct{ *enum.SafeEnum[role] }
+
+	var (
+		RoleUser  = enum.NewExtended[Role]("user")
+		RoleAdmin = enum.NewExtended[Role]("admin")
+	)
+
+	assert.NotNil(t, RoleUser.SafeEnum)
+	assert.Equal(t, "user", RoleUser.String())
+	assert.Equal(t, "admin", RoleAdmin.String())
+	assert.Equal(t, RoleUser, enum.MustFromString[Role]("user"))
+	assert.Equal(t, 1, enum.MustTo[int](RoleAdmin))
+}
+
+func TestNewExtendedMixinFirst(t *testing.T) {
+	type role any
+	type Mixin struct{ Comment string }
+	type Role struct {
+		Mixin
+		enum.SafeEnum[role]
+	}
+
+	var (
+		RoleUser = enum.NewExtended[Role]("user")
+	)
+
+	assert.Equal(t, "user", RoleUser.String())
+	assert.Equal(t, RoleUser, enum.MustFromString[Role]("user"))
+}
+
+func TestNewExtendedAmbiguous(t *testing.T) {
+	type role any
+	type other any
+	type Base struct{ enum.SafeEnum[other] }
+	type Role struct {
+		enum.SafeEnum[role]
+		Base
+	}
+
+	assert.PanicsWithValue(t,
+		"enum Role: NewExtended found multiple embedded enums (SafeEnum, Base.SafeEnum)",
+		func() { enum.NewExtended[Role]("user") },
+	)
+}
+
+func TestNewExtendedNotFound(t *testing.T) {
+	assert.PanicsWithValue(t,
+		"invalid enum type WrapEnum[int]: NewExtended is only used to create an extended enum, "+
+			"otherwise use New or Map instead! (inspected fields: none)",
+		func() { enum.NewExtended[enum.WrapEnum[int]]("user") },
+	)
+
+	type base struct{ enum.SafeEnum[int] }
+	type Mixin struct{}
+	type InvalidRole struct {
+		ID int
+		Mixin
+		base
+	}
+
+	assert.PanicsWithValue(t,
+		"invalid enum type InvalidRole: NewExtended is only used to create an extended enum, "+
+			"otherwise use New or Map instead! (inspected fields: ID (named), Mixin (not enumable), base (unexported))",
+		func() { enum.NewExtended[InvalidRole]("user") },
+	)
+}
+
 func TestSafeEnumPrintZeroStruct(t *testing.T) {
 	type role any
 	type Role = enum.SafeEnum[role]
@@ -716,3 +1241,94 @@ func TestSafeEnumScanSQLInvalid(t *testing.T) {
 	err := enum.ScanSQL([]byte("invalid"), &r)
 	assert.ErrorContains(t, err, "enum SafeEnum[role]: unknown string invalid")
 }
+
+func TestRegisteredFromPackages(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	assert.Nil(t, enum.RegisteredFromPackages[Role]())
+
+	var (
+		_ = enum.New[Role]("user")
+		_ = enum.New[Role]("admin")
+	)
+
+	assert.Equal(t, []string{"github.com/xybor-x/enum/testing_test"}, enum.RegisteredFromPackages[Role]())
+
+	_ = crosspkg.New[Role]("guest")
+	assert.Equal(t, []string{
+		"github.com/xybor-x/enum/testing_test",
+		"github.com/xybor-x/enum/testing/crosspkg",
+	}, enum.RegisteredFromPackages[Role]())
+
+	// The returned slice is a copy.
+	enum.RegisteredFromPackages[Role]()[0] = "modified"
+	assert.Equal(t, "github.com/xybor-x/enum/testing_test", enum.RegisteredFromPackages[Role]()[0])
+}
+
+func TestRegisteredFromPackagesConflict(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		_ = enum.New[Role]("user")
+	)
+
+	assert.PanicsWithValue(t,
+		"enum WrapEnum[role] (1): string user was already mapped to user "+
+			"(registered from packages github.com/xybor-x/enum/testing_test, github.com/xybor-x/enum/testing/crosspkg)",
+		func() { crosspkg.New[Role]("user") })
+}
+
+func TestRegisteredFromPackagesSinglePackageConflict(t *testing.T) {
+	type Role int
+
+	var (
+		_ = enum.New[Role]("user")
+	)
+
+	assert.PanicsWithValue(t, "enum Role (1): string user was already mapped to 0", func() {
+		enum.New[Role]("user")
+	})
+}
+
+func TestEnumConcurrentLookupAndRegistration(t *testing.T) {
+	type Role int
+	type Status int
+
+	var (
+		RoleUser = enum.New[Role]("user")
+		_        = enum.Finalize[Role]()
+	)
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+
+				role, ok := enum.FromString[Role]("user")
+				assert.True(t, ok)
+				assert.Equal(t, RoleUser, role)
+				assert.Equal(t, "user", enum.ToString(RoleUser))
+				assert.Equal(t, "Status", enum.NameOf[Status]())
+			}
+		}()
+	}
+
+	// Register another type while the lookups are running.
+	for i := 0; i < 100; i++ {
+		enum.New[Status](fmt.Sprintf("status%d", i))
+	}
+	close(done)
+	wg.Wait()
+
+	assert.Len(t, enum.All[Status](), 100)
+}
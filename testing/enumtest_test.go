@@ -0,0 +1,166 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+	"github.com/xybor-x/enum/enumtest"
+)
+
+type fillRole int
+
+var (
+	fillRoleUser  = enum.New[fillRole]("user")
+	fillRoleAdmin = enum.New[fillRole]("admin")
+	fillRoleGuest = enum.New[fillRole]("guest")
+)
+
+type fillStatus any
+type FillStatus = enum.SafeEnum[fillStatus]
+
+var (
+	fillStatusActive = enum.New[FillStatus]("active")
+	_                = enum.New[FillStatus]("inactive")
+)
+
+type fillAudit struct {
+	By     fillRole `json:"by"`
+	Status FillStatus
+}
+
+type FillBase struct {
+	Owner fillRole
+}
+
+type fillFixture struct {
+	FillBase
+
+	Name     string
+	Count    int
+	Role     fillRole
+	RolePtr  *fillRole
+	Nullable enum.Nullable[fillRole]
+	Roles    []fillRole
+	Empty    []fillRole
+	Statuses [2]FillStatus
+	ByName   map[string]fillRole
+	Audit    *fillAudit
+	Audits   []fillAudit
+	Missing  *fillAudit
+
+	unexported fillRole
+}
+
+func TestFill(t *testing.T) {
+	fixture := fillFixture{
+		Name:   "fixture",
+		Count:  3,
+		Roles:  make([]fillRole, 2),
+		Empty:  []fillRole{},
+		ByName: map[string]fillRole{"a": 42},
+		Audit:  &fillAudit{},
+		Audits: make([]fillAudit, 2),
+	}
+
+	fixture.Roles[1] = 42
+	fixture.unexported = 42
+
+	assert.NoError(t, enumtest.Fill(&fixture))
+
+	assert.Equal(t, "fixture", fixture.Name)
+	assert.Equal(t, 3, fixture.Count)
+	assert.Equal(t, fillRoleUser, fixture.Owner)
+	assert.Equal(t, fillRoleUser, fixture.Role)
+	if assert.NotNil(t, fixture.RolePtr) {
+		assert.Equal(t, fillRoleUser, *fixture.RolePtr)
+	}
+	assert.Equal(t, enum.Nullable[fillRole]{Enum: fillRoleUser, Valid: true}, fixture.Nullable)
+	assert.Equal(t, []fillRole{fillRoleUser, fillRoleUser}, fixture.Roles)
+	assert.Empty(t, fixture.Empty)
+	assert.Equal(t, [2]FillStatus{fillStatusActive, fillStatusActive}, fixture.Statuses)
+	assert.Equal(t, map[string]fillRole{"a": fillRoleUser}, fixture.ByName)
+	assert.Equal(t, &fillAudit{By: fillRoleUser, Status: fillStatusActive}, fixture.Audit)
+	assert.Equal(t, []fillAudit{
+		{By: fillRoleUser, Status: fillStatusActive},
+		{By: fillRoleUser, Status: fillStatusActive},
+	}, fixture.Audits)
+	assert.Nil(t, fixture.Missing)
+	assert.Equal(t, fillRole(42), fixture.unexported)
+
+	_, err := json.Marshal(fixture)
+	assert.NoError(t, err)
+}
+
+func TestFillWithSeed(t *testing.T) {
+	type fixture struct {
+		A, B, C, D, E, F, G, H fillRole
+	}
+
+	var first, second fixture
+	assert.NoError(t, enumtest.Fill(&first, enumtest.WithSeed(7)))
+	assert.NoError(t, enumtest.Fill(&second, enumtest.WithSeed(7)))
+	assert.Equal(t, first, second)
+
+	seen := map[fillRole]bool{}
+	for _, role := range []fillRole{first.A, first.B, first.C, first.D, first.E, first.F, first.G, first.H} {
+		assert.Contains(t, []fillRole{fillRoleUser, fillRoleAdmin, fillRoleGuest}, role)
+		seen[role] = true
+	}
+	assert.Greater(t, len(seen), 1)
+}
+
+func TestFillUnregistered(t *testing.T) {
+	type empty any
+
+	type fixture struct {
+		Role     fillRole
+		Missing  enum.WrapEnum[empty]
+		Nullable enum.Nullable[enum.WrapEnum[empty]]
+	}
+
+	var f fixture
+	err := enumtest.Fill(&f)
+	assert.ErrorContains(t, err, "enumtest: Missing: enum enum.WrapEnum[")
+	assert.ErrorContains(t, err, "enumtest: Nullable: enum enum.WrapEnum[")
+	assert.ErrorContains(t, err, "has no registered values")
+	assert.Equal(t, fillRoleUser, f.Role)
+}
+
+func TestFillInvalidArgument(t *testing.T) {
+	var f fillFixture
+	assert.EqualError(t, enumtest.Fill(f),
+		"enumtest: Fill requires a non-nil pointer to a struct, got testing_test.fillFixture")
+	assert.Error(t, enumtest.Fill((*fillFixture)(nil)))
+	assert.Error(t, enumtest.Fill(new(int)))
+}
+
+func TestRunContract(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type status any
+	type Status = enum.SafeEnum[status]
+	type Level int
+	type score any
+	type Score = enum.WrapFloatEnum[score]
+
+	var (
+		_ = enum.New[Role]("user", 1)
+		_ = enum.New[Role]("admin", 2)
+		_ = enum.New[Status]("active")
+		_ = enum.New[Status]("o'brien \"quoted\"")
+		_ = enum.New[Level]("low")
+		_ = enum.New[Level]("high")
+		_ = enum.New[Score]("half", 0.5)
+	)
+
+	enum.SetJSONFormat[Level](enum.JSONNumber)
+	enum.SetSQLFormat[Level](enum.SQLNumber)
+	enum.SetMarshalInvalidAsNull[Role](true)
+
+	enumtest.RunContract[Role](t)
+	enumtest.RunContract[Status](t)
+	enumtest.RunContract[Level](t)
+	enumtest.RunContract[Score](t)
+}
@@ -0,0 +1,85 @@
+package testing_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestExpect(t *testing.T) {
+	type Role int
+
+	var (
+		_ = enum.New[Role]("user")
+		_ = enum.New[Role]("admin")
+	)
+
+	assert.True(t, enum.Expect[Role]("admin", "user"))
+	assert.NotPanics(t, func() { enum.Finalize[Role]() })
+}
+
+func TestExpectTypoAtFinalize(t *testing.T) {
+	type Role int
+
+	_, file, line, _ := runtime.Caller(0)
+	var _ = enum.Expect[Role]("admin", "adminn", "usr")
+
+	enum.New[Role]("user")
+	enum.New[Role]("admin")
+
+	site := fmt.Sprintf("%s:%d", file, line+1)
+	assert.PanicsWithValue(t,
+		"enum Role: expected string adminn is not registered (expected at "+site+")\n"+
+			"enum Role: expected string usr is not registered (expected at "+site+")",
+		func() { enum.Finalize[Role]() })
+}
+
+func TestExpectBeforeMap(t *testing.T) {
+	type Role int
+
+	// Package-level variables may be initialized before the enum values.
+	var _ = enum.Expect[Role]("user")
+
+	var _ = enum.Map(Role(1), "user")
+
+	assert.NotPanics(t, func() { enum.Finalize[Role]() })
+}
+
+func TestExpectAfterFinalize(t *testing.T) {
+	type Role int
+
+	enum.New[Role]("user")
+	enum.Finalize[Role]()
+
+	assert.Panics(t, func() { enum.Expect[Role]("admin") })
+}
+
+func TestCheckExpectations(t *testing.T) {
+	type Mood int
+	type Phase int
+
+	enum.Expect[Mood]("user", "guest")
+	enum.Expect[Phase]("pending")
+
+	enum.New[Mood]("user")
+
+	var messages []string
+	for _, err := range enum.CheckExpectations() {
+		messages = append(messages, err.Error())
+	}
+
+	assert.Contains(t, fmt.Sprint(messages), "enum Mood: expected string guest is not registered")
+	assert.Contains(t, fmt.Sprint(messages), "enum Phase: expected string pending is not registered")
+	assert.NotContains(t, fmt.Sprint(messages), "expected string user")
+
+	enum.New[Mood]("guest")
+	enum.New[Phase]("pending")
+
+	for _, err := range enum.CheckExpectations() {
+		assert.NotContains(t, err.Error(), "enum Mood:")
+		assert.NotContains(t, err.Error(), "enum Phase:")
+	}
+}
@@ -0,0 +1,105 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+type fieldRole int
+
+var (
+	fieldRoleUser  = enum.New[fieldRole]("user")
+	fieldRoleAdmin = enum.New[fieldRole]("admin")
+)
+
+type fieldStatus int
+
+var (
+	_ = enum.New[fieldStatus]("active")
+	_ = enum.New[fieldStatus]("inactive")
+)
+
+func TestDecodeField(t *testing.T) {
+	role, err := enum.DecodeField[fieldRole]("admin", "role")
+	assert.Nil(t, err)
+	assert.Equal(t, fieldRoleAdmin, role)
+
+	role, err = enum.DecodeField[fieldRole]("amdin", "role")
+	assert.Equal(t, fieldRole(0), role)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "role", err.Field)
+		assert.Equal(t, "amdin", err.Value)
+		assert.Equal(t, "fieldRole", err.Type)
+		assert.Equal(t, []string{"user", "admin"}, err.Allowed)
+		assert.Equal(t, "admin", err.Suggestion)
+		assert.EqualError(t, err, "field role: enum fieldRole: unknown string amdin (did you mean admin?)")
+
+		var unknownErr *enum.UnknownStringError
+		assert.ErrorAs(t, err, &unknownErr)
+	}
+
+	_, err = enum.DecodeField[fieldRole]("moderator", "role")
+	if assert.NotNil(t, err) {
+		assert.Empty(t, err.Suggestion)
+		assert.EqualError(t, err, "field role: enum fieldRole: unknown string moderator")
+	}
+}
+
+func TestDecodeFieldCapsAllowed(t *testing.T) {
+	type fieldCountry int
+
+	for i := 0; i < 30; i++ {
+		enum.New[fieldCountry](fmt.Sprintf("c%02d", i))
+	}
+
+	_, err := enum.DecodeField[fieldCountry]("xx", "country")
+	if assert.NotNil(t, err) {
+		assert.Len(t, err.Allowed, 20)
+		assert.Equal(t, "c00", err.Allowed[0])
+	}
+}
+
+func TestCollectFieldErrors(t *testing.T) {
+	role, roleErr := enum.DecodeField[fieldRole]("user", "role")
+	assert.Equal(t, fieldRoleUser, role)
+	_, statusErr := enum.DecodeField[fieldStatus]("active", "status")
+	assert.NoError(t, enum.CollectFieldErrors(roleErr, statusErr))
+	assert.NoError(t, enum.CollectFieldErrors())
+
+	_, roleErr = enum.DecodeField[fieldRole]("amdin", "role")
+	assert.Equal(t, enum.FieldErrors{roleErr}, enum.CollectFieldErrors(roleErr, statusErr))
+
+	_, statusErr = enum.DecodeField[fieldStatus]("unknown", "status")
+	err := enum.CollectFieldErrors(roleErr, statusErr)
+	assert.EqualError(t, err, "field role: enum fieldRole: unknown string amdin (did you mean admin?)\n"+
+		"field status: enum fieldStatus: unknown string unknown")
+
+	var fieldErrs enum.FieldErrors
+	if assert.ErrorAs(t, err, &fieldErrs) {
+		assert.Equal(t, enum.FieldErrors{roleErr, statusErr}, fieldErrs)
+	}
+
+	var fieldErr *enum.FieldError
+	if assert.ErrorAs(t, err, &fieldErr) {
+		assert.Same(t, roleErr, fieldErr)
+	}
+
+	assert.True(t, errors.Is(err, statusErr))
+}
+
+func TestFieldErrorsMarshalJSON(t *testing.T) {
+	_, roleErr := enum.DecodeField[fieldRole]("amdin", "role")
+	_, statusErr := enum.DecodeField[fieldStatus]("unknown", "status")
+
+	data, err := json.Marshal(enum.CollectFieldErrors(roleErr, statusErr))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"errors":[
+		{"field":"role","value":"amdin","allowed":["user","admin"],"suggestion":"admin"},
+		{"field":"status","value":"unknown","allowed":["active","inactive"]}
+	]}`, string(data))
+}
@@ -0,0 +1,148 @@
+package testing_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestOnFinalize(t *testing.T) {
+	type Role int
+
+	var calls []string
+	enum.OnFinalize(func(values []Role) {
+		calls = append(calls, fmt.Sprintf("first %d", len(values)))
+	})
+	enum.OnFinalize(func(values []Role) {
+		calls = append(calls, fmt.Sprintf("second %d", len(values)))
+	})
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	assert.Empty(t, calls)
+
+	enum.OnFinalize(func(values []Role) {
+		assert.Equal(t, []Role{RoleUser, RoleAdmin}, values)
+		calls = append(calls, "third")
+	})
+
+	enum.Finalize[Role]()
+	assert.Equal(t, []string{"first 2", "second 2", "third"}, calls)
+
+	// Callbacks are invoked exactly once.
+	enum.Finalize[Role]()
+	assert.Equal(t, []string{"first 2", "second 2", "third"}, calls)
+
+	// The callback is invoked immediately if the type was already finalized.
+	enum.OnFinalize(func(values []Role) {
+		calls = append(calls, fmt.Sprintf("late %d", len(values)))
+	})
+	assert.Equal(t, []string{"first 2", "second 2", "third", "late 2"}, calls)
+}
+
+func TestOnFinalizeValuesCopy(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	enum.OnFinalize(func(values []Role) {
+		values[0], values[1] = values[1], values[0]
+	})
+	enum.Finalize[Role]()
+
+	assert.Equal(t, []Role{RoleUser, RoleAdmin}, enum.All[Role]())
+}
+
+func TestOnAnyFinalize(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type Status int
+
+	var names []string
+	remove := enum.OnAnyFinalize(func(typeName string) {
+		names = append(names, typeName)
+	})
+	defer remove()
+
+	var calls []string
+	enum.OnFinalize(func([]Role) {
+		calls = append(calls, "typed")
+		assert.Empty(t, names)
+	})
+
+	enum.Finalize[Role]()
+	enum.Finalize[Status]()
+	enum.Finalize[Status]()
+
+	assert.Equal(t, []string{"typed"}, calls)
+	assert.Contains(t, names, "WrapEnum[role]")
+	assert.Contains(t, names, "Status")
+	assert.Len(t, names, 2)
+
+	// Removed callbacks are not invoked anymore.
+	type Level int
+
+	remove()
+	enum.Finalize[Level]()
+	assert.Len(t, names, 2)
+}
+
+func TestOnFinalizePanic(t *testing.T) {
+	type Role int
+
+	_, file, line, _ := runtime.Caller(0)
+	enum.OnFinalize(func([]Role) { panic("boom") })
+
+	assert.PanicsWithValue(t,
+		fmt.Sprintf("enum Role: OnFinalize callback registered at %s:%d panicked: boom", file, line+1),
+		func() { enum.Finalize[Role]() })
+}
+
+func TestOnFinalizePanicAfterFinalize(t *testing.T) {
+	type Role int
+
+	enum.Finalize[Role]()
+
+	_, file, line, _ := runtime.Caller(0)
+	assert.PanicsWithValue(t,
+		fmt.Sprintf("enum Role: OnFinalize callback registered at %s:%d panicked: boom", file, line+4),
+		func() {
+			enum.OnFinalize(func([]Role) { panic("boom") })
+		})
+}
+
+func TestOnFinalizePanicRunsRemainingCallbacks(t *testing.T) {
+	type Role int
+
+	var calls []string
+	remove := enum.OnAnyFinalize(func(typeName string) {
+		if typeName == "Role" {
+			calls = append(calls, "any")
+		}
+	})
+	defer remove()
+
+	_, file, line, _ := runtime.Caller(0)
+	enum.OnFinalize(func([]Role) { panic("boom") })
+	enum.OnFinalize(func([]Role) { calls = append(calls, "second") })
+	enum.OnFinalize(func([]Role) { panic("bang") })
+
+	assert.PanicsWithValue(t,
+		fmt.Sprintf("enum Role: OnFinalize callback registered at %s:%d panicked: boom; "+
+			"enum Role: OnFinalize callback registered at %s:%d panicked: bang", file, line+1, file, line+3),
+		func() { enum.Finalize[Role]() })
+
+	// Every callback was invoked exactly once.
+	assert.Equal(t, []string{"second", "any"}, calls)
+	enum.Finalize[Role]()
+	assert.Equal(t, []string{"second", "any"}, calls)
+}
@@ -0,0 +1,69 @@
+package testing_test
+
+import (
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestFlag(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	parse := func(args ...string) (Role, error) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+
+		r := RoleUser
+		fs.Var(enum.Flag(&r), "role", "the role")
+		return r, fs.Parse(args)
+	}
+
+	r, err := parse()
+	assert.NoError(t, err)
+	assert.Equal(t, RoleUser, r)
+
+	r, err = parse("-role=admin")
+	assert.NoError(t, err)
+	assert.Equal(t, RoleAdmin, r)
+
+	_, err = parse("-role=guest")
+	assert.ErrorContains(t, err, "enum Role: unknown string guest (allowed values: user, admin)")
+}
+
+func TestFlagWrapEnum(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	r := RoleUser
+	fs.Var(enum.Flag(&r), "role", "the role")
+
+	f := fs.Lookup("role")
+	assert.Equal(t, "user", f.DefValue)
+
+	assert.NoError(t, fs.Parse([]string{"-role", "admin"}))
+	assert.Equal(t, RoleAdmin, r)
+	assert.Equal(t, "admin", f.Value.String())
+	assert.Equal(t, RoleAdmin, f.Value.(flag.Getter).Get())
+
+	assert.Error(t, fs.Parse([]string{"-role", "guest"}))
+	assert.Equal(t, RoleAdmin, r)
+
+	invalid := Role(42)
+	assert.Equal(t, "<nil>", enum.Flag(&invalid).String())
+}
@@ -0,0 +1,51 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestFromStringFold(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.NewOpts[Role](enum.Str("Admin"), enum.Alias("ROOT"), enum.Alias("admin"))
+	)
+
+	for _, s := range []string{"user", "User", "USER", "uSeR"} {
+		role, ok := enum.FromStringFold[Role](s)
+		assert.True(t, ok, s)
+		assert.Equal(t, RoleUser, role, s)
+	}
+
+	for _, s := range []string{"Admin", "admin", "ADMIN", "root", "Root"} {
+		assert.Equal(t, RoleAdmin, enum.MustFromStringFold[Role](s), s)
+	}
+
+	_, ok := enum.FromStringFold[Role]("moderator")
+	assert.False(t, ok)
+	_, ok = enum.FromStringFold[Role]("")
+	assert.False(t, ok)
+	assert.Equal(t, Role(0), enum.MustFromStringFold[Role]("unknown"))
+
+	// The index follows the strings mapped after its first use.
+	RoleMod := enum.New[Role]("Moderator")
+	assert.Equal(t, RoleMod, enum.MustFromStringFold[Role]("moderator"))
+}
+
+func TestFromStringFoldAmbiguous(t *testing.T) {
+	type Role int
+
+	var (
+		_ = enum.New[Role]("admin")
+		_ = enum.New[Role]("ADMIN")
+	)
+
+	assert.Equal(t, Role(1), enum.MustFromStringFold[Role]("ADMIN"))
+	assert.PanicsWithValue(t, "enum Role: strings admin and ADMIN differ only by case", func() {
+		enum.FromStringFold[Role]("Admin")
+	})
+}
@@ -0,0 +1,138 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+type mutatorCase struct {
+	name     string
+	typeName string
+	metadata bool
+	run      func()
+}
+
+// mutatorCases returns a call of every public mutator of the Role type, which
+// must have a "user" value.
+func mutatorCases[Role ~int, S any](newExtended func()) []mutatorCase {
+	return []mutatorCase{
+		{"Map", "Role", false, func() { enum.Map(Role(100), "mapped") }},
+		{"New", "Role", false, func() { enum.New[Role](101, "new") }},
+		{"NewExtended", "ExtRole", false, newExtended},
+		{"MapPair", "Role", false, func() { enum.MapPair(enum.MustFromString[Role]("user"), enum.Pair(1, 1)) }},
+		{"SetStringMatcher", "Role", false, func() { enum.SetStringMatcher[Role](enum.MatchFoldNFC) }},
+		{"SetParseOptions", "Role", false, func() { enum.SetParseOptions[Role](enum.TrimSpace()) }},
+		{"MapAlias", "Role", false, func() { enum.MapAlias(enum.MustFromString[Role]("user"), "customer") }},
+		{"MapLegacyString", "Role", false, func() { enum.MapLegacyString(enum.MustFromString[Role]("user"), "member") }},
+		{"MapDesc", "Role", true, func() { enum.MapDesc(enum.MustFromString[Role]("user"), "a user") }},
+		{"DeclareSubsetType", "Role", true, func() { enum.DeclareSubsetType[S](enum.MustFromString[Role]("user")) }},
+		{"DefineTransitions", "Role", true, func() {
+			enum.DefineTransitions(map[Role][]Role{enum.MustFromString[Role]("user"): {}})
+		}},
+		{"SetErrorValueListing", "Role", true, func() { enum.SetErrorValueListing[Role](true) }},
+		{"AcceptLegacyNumbers", "Role", true, func() { enum.AcceptLegacyNumbers[Role](true) }},
+		{"SetJSONBMapLenient", "Role", true, func() { enum.SetJSONBMapLenient[Role](true) }},
+		{"UsePairFormat", "Role", true, func() { enum.UsePairFormat[Role](false) }},
+		{"RequireSingleNumberingStyle", "Role", true, func() { enum.RequireSingleNumberingStyle[Role]() }},
+		{"SetMarshalInvalidAsNull", "Role", true, func() { enum.SetMarshalInvalidAsNull[Role](true) }},
+		{"SetJSONNullIsError", "Role", true, func() { enum.SetJSONNullIsError[Role](true) }},
+		{"SetJSONFormat", "Role", true, func() { enum.SetJSONFormat[Role](enum.JSONNumber) }},
+		{"SetSQLFormat", "Role", true, func() { enum.SetSQLFormat[Role](enum.SQLNumber) }},
+		{"SetGormDataType", "Role", true, func() { enum.SetGormDataType[Role]("role") }},
+	}
+}
+
+func TestMutatorsOpen(t *testing.T) {
+	type Role int
+	type subset struct{}
+	type extRole any
+	type ExtRole struct{ enum.SafeEnum[extRole] }
+
+	enum.Map(Role(0), "user")
+
+	for _, c := range mutatorCases[Role, subset](func() { enum.NewExtended[ExtRole]("new") }) {
+		assert.NotPanics(t, c.run, c.name)
+	}
+
+	assert.Len(t, enum.All[Role](), 3)
+	assert.Len(t, enum.All[ExtRole](), 1)
+	assert.Equal(t, "a user", enum.DescOf(enum.MustFromString[Role]("user")))
+}
+
+func TestMutatorsSealed(t *testing.T) {
+	type Role int
+	type subset struct{}
+	type extRole any
+	type ExtRole struct{ enum.SafeEnum[extRole] }
+
+	enum.Map(Role(0), "user")
+	enum.Finalize[Role]()
+	enum.Finalize[ExtRole]()
+
+	for _, c := range mutatorCases[Role, subset](func() { enum.NewExtended[ExtRole]("new") }) {
+		if c.metadata {
+			assert.NotPanics(t, c.run, c.name)
+		} else {
+			assert.PanicsWithValue(t, "enum "+c.typeName+": the enum was already finalized", c.run, c.name)
+		}
+	}
+
+	assert.Len(t, enum.All[Role](), 1)
+	assert.Empty(t, enum.All[ExtRole]())
+	assert.Empty(t, enum.All[enum.SafeEnum[extRole]](), "the embedded enum must not be mapped")
+	assert.Equal(t, "a user", enum.DescOf(enum.MustFromString[Role]("user")))
+}
+
+func TestMutatorsFrozen(t *testing.T) {
+	type Role int
+	type subset struct{}
+	type extRole any
+	type ExtRole struct{ enum.SafeEnum[extRole] }
+
+	enum.Map(Role(0), "user")
+	enum.Freeze[Role]()
+	enum.Freeze[ExtRole]()
+
+	messages := map[string]string{
+		"MapDesc":                     "enum Role: cannot describe a value, the enum was already frozen",
+		"DeclareSubsetType":           "enum Role: cannot declare a subset, the enum was already frozen",
+		"DefineTransitions":           "enum Role: cannot define transitions, the enum was already frozen",
+		"SetErrorValueListing":        "enum Role: cannot configure the value listing, the enum was already frozen",
+		"AcceptLegacyNumbers":         "enum Role: cannot configure legacy numbers, the enum was already frozen",
+		"SetJSONBMapLenient":          "enum Role: cannot configure JSONBMap, the enum was already frozen",
+		"UsePairFormat":               "enum Role: cannot configure the pair format, the enum was already frozen",
+		"RequireSingleNumberingStyle": "enum Role: cannot require a single numbering style, the enum was already frozen",
+		"SetMarshalInvalidAsNull":     "enum Role: cannot configure the invalid marshaling, the enum was already frozen",
+		"SetJSONNullIsError":          "enum Role: cannot configure the JSON null, the enum was already frozen",
+		"SetJSONFormat":               "enum Role: cannot configure the JSON format, the enum was already frozen",
+		"SetSQLFormat":                "enum Role: cannot configure the SQL format, the enum was already frozen",
+		"SetGormDataType":             "enum Role: cannot set the GORM data type, the enum was already frozen",
+	}
+
+	for _, c := range mutatorCases[Role, subset](func() { enum.NewExtended[ExtRole]("new") }) {
+		if c.metadata {
+			assert.PanicsWithValue(t, messages[c.name], c.run, c.name)
+		} else {
+			assert.PanicsWithValue(t, "enum "+c.typeName+": the enum was already finalized", c.run, c.name)
+		}
+	}
+
+	assert.Len(t, enum.All[Role](), 1)
+	assert.Empty(t, enum.DescOf(enum.MustFromString[Role]("user")))
+}
+
+func TestFreezeRunsFinalizeHooks(t *testing.T) {
+	type Role int
+
+	enum.Map(Role(0), "user")
+
+	calls := 0
+	enum.OnFinalize(func([]Role) { calls++ })
+
+	assert.True(t, enum.Freeze[Role]())
+	assert.True(t, enum.Finalize[Role]())
+	assert.True(t, enum.Freeze[Role]())
+	assert.Equal(t, 1, calls)
+}
@@ -3,14 +3,24 @@ module github.com/xybor-x/enum/testing
 go 1.21.1
 
 require (
+	github.com/fxamacker/cbor/v2 v2.9.0
 	github.com/mattn/go-sqlite3 v1.14.24
+	github.com/pelletier/go-toml/v2 v2.2.4
 	github.com/stretchr/testify v1.10.0
+	github.com/swaggest/jsonschema-go v0.3.70
 	github.com/xybor-x/enum v0.3.0
 	google.golang.org/protobuf v1.36.0
 	gopkg.in/yaml.v3 v3.0.1
+	gorm.io/driver/sqlite v1.5.7
+	gorm.io/gorm v1.25.12
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/swaggest/refl v1.3.0 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
+	golang.org/x/text v0.14.0 // indirect
 )
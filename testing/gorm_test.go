@@ -0,0 +1,139 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestGormDataType(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type SafeRole = enum.SafeEnum[role]
+	type Level = enum.WrapUintEnum[role]
+
+	enum.New[Role]("user")
+	enum.New[SafeRole]("user")
+	enum.New[Level]("low")
+
+	assert.Equal(t, "string", Role(0).GormDataType())
+	assert.Equal(t, "string", SafeRole{}.GormDataType())
+	assert.Equal(t, "string", enum.Nullable[Role]{}.GormDataType())
+
+	enum.SetSQLFormat[Level](enum.SQLNumber)
+	assert.Equal(t, "uint", Level(0).GormDataType())
+
+	type Score = enum.WrapFloatEnum[role]
+	type Rank int
+
+	enum.New[Score]("low", 0.5)
+	enum.New[Rank]("first")
+
+	enum.SetSQLFormat[Score](enum.SQLNumber)
+	enum.SetSQLFormat[Rank](enum.SQLNumber)
+	assert.Equal(t, "float", Score(0).GormDataType())
+	assert.Equal(t, "int", enum.GormDataType[Rank]())
+
+	enum.SetGormDataType[Role]("role")
+	assert.Equal(t, "role", Role(0).GormDataType())
+	assert.Equal(t, "role", enum.Nullable[Role]{}.GormDataType())
+}
+
+func TestGormRoundTrip(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type SafeRole = enum.SafeEnum[role]
+	type level any
+	type Level = enum.WrapEnum[level]
+
+	var (
+		_         = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+
+		SafeRoleUser = enum.New[SafeRole]("user")
+
+		_         = enum.New[Level]("low")
+		LevelHigh = enum.New[Level]("high", 10)
+	)
+
+	enum.SetSQLFormat[Level](enum.SQLNumber)
+
+	type Account struct {
+		ID       uint
+		Role     Role
+		SafeRole SafeRole
+		Backup   enum.Nullable[Role]
+		Level    Level
+	}
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&Account{}))
+
+	columns, err := db.Migrator().ColumnTypes(&Account{})
+	assert.NoError(t, err)
+
+	types := make(map[string]string)
+	for _, column := range columns {
+		types[column.Name()] = column.DatabaseTypeName()
+	}
+
+	assert.Equal(t, "text", types["role"])
+	assert.Equal(t, "text", types["safe_role"])
+	assert.Equal(t, "text", types["backup"])
+	assert.Equal(t, "integer", types["level"])
+
+	account := Account{Role: RoleAdmin, SafeRole: SafeRoleUser, Level: LevelHigh}
+	assert.NoError(t, db.Create(&account).Error)
+
+	var got Account
+	assert.NoError(t, db.First(&got, account.ID).Error)
+	assert.Equal(t, account, got)
+
+	var stored int
+	assert.NoError(t, db.Raw("SELECT level FROM accounts WHERE id = ?", account.ID).Scan(&stored).Error)
+	assert.Equal(t, 10, stored)
+}
+
+func TestGormRoundTripFloat(t *testing.T) {
+	type score any
+	type Score = enum.WrapFloatEnum[score]
+
+	var (
+		_         = enum.New[Score]("low", 0.5)
+		ScoreHigh = enum.New[Score]("high", 2.5)
+	)
+
+	enum.SetSQLFormat[Score](enum.SQLNumber)
+
+	type Review struct {
+		ID    uint
+		Score Score
+	}
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, db.AutoMigrate(&Review{}))
+
+	columns, err := db.Migrator().ColumnTypes(&Review{})
+	assert.NoError(t, err)
+	for _, column := range columns {
+		if column.Name() == "score" {
+			assert.Equal(t, "real", column.DatabaseTypeName())
+		}
+	}
+
+	review := Review{Score: ScoreHigh}
+	assert.NoError(t, db.Create(&review).Error)
+
+	var got Review
+	assert.NoError(t, db.First(&got, review.ID).Error)
+	assert.Equal(t, review, got)
+
+	var stored float64
+	assert.NoError(t, db.Raw("SELECT score FROM reviews WHERE id = ?", review.ID).Scan(&stored).Error)
+	assert.Equal(t, 2.5, stored)
+}
@@ -0,0 +1,74 @@
+package testing_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+// gqlMarshaler and gqlUnmarshaler mirror graphql.Marshaler and
+// graphql.Unmarshaler of gqlgen, which binds a scalar to any type implementing
+// both.
+type gqlMarshaler interface {
+	MarshalGQL(w io.Writer)
+}
+
+type gqlUnmarshaler interface {
+	UnmarshalGQL(v any) error
+}
+
+func TestWrapEnumGQL(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin", 5)
+	)
+
+	var buf bytes.Buffer
+	RoleAdmin.MarshalGQL(&buf)
+	assert.Equal(t, `"admin"`, buf.String())
+
+	buf.Reset()
+	Role(42).MarshalGQL(&buf)
+	assert.Equal(t, `null`, buf.String())
+
+	var r Role
+	assert.NoError(t, r.UnmarshalGQL("user"))
+	assert.Equal(t, RoleUser, r)
+
+	assert.NoError(t, r.UnmarshalGQL(json.Number("5")))
+	assert.Equal(t, RoleAdmin, r)
+
+	assert.ErrorContains(t, r.UnmarshalGQL("guest"), "enum WrapEnum[role]: unknown string guest")
+	assert.EqualError(t, r.UnmarshalGQL(json.Number("9")), "enum WrapEnum[role]: unknown number 9")
+	assert.EqualError(t, r.UnmarshalGQL(true), "enum WrapEnum[role]: not support type bool")
+}
+
+func TestSafeEnumGQLScalar(t *testing.T) {
+	type role any
+	type Role = enum.SafeEnum[role]
+
+	var (
+		RoleUser = enum.New[Role]("user")
+	)
+
+	// Bind the scalar the way gqlgen does: decode the input through the
+	// pointer, then write the result through the value.
+	var r Role
+	var unmarshaler gqlUnmarshaler = &r
+	assert.NoError(t, unmarshaler.UnmarshalGQL("user"))
+	assert.Equal(t, RoleUser, r)
+
+	var marshaler gqlMarshaler = r
+	var buf bytes.Buffer
+	marshaler.MarshalGQL(&buf)
+	assert.Equal(t, `"user"`, buf.String())
+
+	assert.ErrorContains(t, unmarshaler.UnmarshalGQL("guest"), "enum SafeEnum[role]: unknown string guest")
+}
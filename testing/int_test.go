@@ -0,0 +1,111 @@
+package testing_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+// intResults are the results of IntE, IntOr(-1) and ToInt.
+type intResults struct {
+	i     int
+	err   error
+	or    int
+	toInt int
+}
+
+func intResultsOf[Enum interface {
+	IntE() (int, error)
+	IntOr(int) int
+}](e Enum) intResults {
+	i, err := e.IntE()
+	return intResults{i: i, err: err, or: e.IntOr(-1), toInt: enum.ToInt(e)}
+}
+
+func TestIntMethods(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type SafeRole = enum.SafeEnum[role]
+
+	var (
+		_         = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+
+		_             = enum.New[SafeRole]("user")
+		SafeRoleAdmin = enum.New[SafeRole]("admin")
+	)
+
+	// The receivers are valid with the int representation 1, then invalid.
+	for _, c := range []struct {
+		name           string
+		valid, invalid intResults
+	}{
+		{"WrapEnum", intResultsOf(RoleAdmin), intResultsOf(Role(42))},
+		{"SafeEnum", intResultsOf(SafeRoleAdmin), intResultsOf(SafeRole{})},
+	} {
+		assert.Equal(t, intResults{i: 1, or: 1, toInt: 1}, c.valid, c.name)
+
+		assert.Error(t, c.invalid.err, c.name)
+		c.invalid.err = nil
+		assert.Equal(t, intResults{i: 0, or: -1, toInt: math.MinInt32}, c.invalid, c.name)
+	}
+
+	assert.EqualError(t, func() error { _, err := Role(42).IntE(); return err }(),
+		"enum WrapEnum[role]: invalid value 42")
+
+	assert.Equal(t, 1, RoleAdmin.Int())
+	assert.Equal(t, 1, SafeRoleAdmin.Int())
+	assert.PanicsWithValue(t, "enum WrapEnum[role]: invalid value 42", func() { Role(42).Int() })
+	assert.PanicsWithValue(t, "enum SafeEnum[role]: invalid value <nil>", func() { SafeRole{}.Int() })
+}
+
+func TestIntMethodsLegacySentinel(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type SafeRole = enum.SafeEnum[role]
+
+	var (
+		RoleUser     = enum.New[Role]("user")
+		SafeRoleUser = enum.New[SafeRole]("user")
+	)
+
+	enum.LegacyIntSentinel(true)
+	defer enum.LegacyIntSentinel(false)
+
+	assert.Equal(t, 0, RoleUser.Int())
+	assert.Equal(t, 0, SafeRoleUser.Int())
+	// Invalid enums return 0, as Int did before it panicked.
+	assert.Equal(t, 0, Role(42).Int())
+	assert.Equal(t, 0, SafeRole{}.Int())
+
+	// The sentinel only affects Int, ToInt keeps its own sentinel.
+	assert.Equal(t, math.MinInt32, enum.ToInt(Role(42)))
+	_, err := Role(42).IntE()
+	assert.Error(t, err)
+	assert.Equal(t, -1, Role(42).IntOr(-1))
+}
+
+func TestIntOrUnsignedAndFloat(t *testing.T) {
+	type role any
+	type URole = enum.WrapUintEnum[role]
+	type FRole = enum.WrapFloatEnum[role]
+
+	var (
+		_          = enum.New[URole]("user")
+		URoleAdmin = enum.New[URole]("admin")
+		_          = enum.New[FRole]("user")
+		FRoleAdmin = enum.New[FRole]("admin")
+	)
+
+	assert.Equal(t, 1, URoleAdmin.IntOr(-1))
+	assert.Equal(t, -1, URole(42).IntOr(-1))
+	assert.Equal(t, 1, enum.ToInt(URoleAdmin))
+	assert.Equal(t, math.MinInt32, enum.ToInt(URole(42)))
+
+	assert.Equal(t, 1, FRoleAdmin.IntOr(-1))
+	assert.Equal(t, -1, FRole(4.2).IntOr(-1))
+	assert.Equal(t, 1, enum.ToInt(FRoleAdmin))
+	assert.Equal(t, math.MinInt32, enum.ToInt(FRole(4.2)))
+}
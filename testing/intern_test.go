@@ -0,0 +1,113 @@
+package testing_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+const (
+	vocabularySize  = 10000
+	vocabularyWidth = 64
+)
+
+// vocabularyWord returns a freshly allocated word of the vocabulary.
+func vocabularyWord(prefix string, i int) string {
+	return fmt.Sprintf("%s-%0*d", prefix, vocabularyWidth-len(prefix)-1, i)
+}
+
+func registerVocabulary[Enum ~int](prefix string) {
+	for i := 0; i < vocabularySize; i++ {
+		enum.Map(Enum(i), vocabularyWord(prefix, i))
+	}
+}
+
+// retainedStringBytes returns the approximate number of bytes retained by the
+// string representations of the enum type, not counting the backing storage
+// which is already in seen.
+func retainedStringBytes[Enum any](seen map[*byte]bool) int {
+	retained := 0
+	for _, value := range enum.All[Enum]() {
+		s := enum.ToString(value)
+		if !seen[unsafe.StringData(s)] {
+			seen[unsafe.StringData(s)] = true
+			retained += len(s)
+		}
+	}
+
+	return retained
+}
+
+func assertVocabulary[Enum any](t *testing.T, prefix string) {
+	for i := 0; i < vocabularySize; i++ {
+		word := vocabularyWord(prefix, i)
+
+		value, ok := enum.FromString[Enum](word)
+		if !assert.True(t, ok, word) {
+			return
+		}
+
+		assert.Equal(t, word, enum.ToString(value))
+		data, err := enum.MarshalJSON(value)
+		assert.NoError(t, err)
+		assert.Equal(t, strconv.Quote(word), string(data))
+	}
+}
+
+func TestInternSharedVocabulary(t *testing.T) {
+	if testing.Short() {
+		t.Skip("registers 80k values")
+	}
+
+	type (
+		country1  int
+		country2  int
+		country3  int
+		country4  int
+		distinct1 int
+		distinct2 int
+		distinct3 int
+		distinct4 int
+	)
+
+	uniqueBefore, refsBefore := enum.InternStats()
+
+	registerVocabulary[distinct1]("d1")
+	registerVocabulary[distinct2]("d2")
+	registerVocabulary[distinct3]("d3")
+	registerVocabulary[distinct4]("d4")
+
+	registerVocabulary[country1]("country")
+	registerVocabulary[country2]("country")
+	registerVocabulary[country3]("country")
+	registerVocabulary[country4]("country")
+
+	// Every value interns its string and its quoted JSON form.
+	unique, refs := enum.InternStats()
+	assert.Equal(t, 5*2*vocabularySize, unique-uniqueBefore)
+	assert.Equal(t, 8*2*vocabularySize, refs-refsBefore)
+
+	// Without interning, the shared vocabulary would retain as many bytes as
+	// the distinct ones.
+	seen := make(map[*byte]bool)
+	distinctBytes := retainedStringBytes[distinct1](seen) + retainedStringBytes[distinct2](seen) +
+		retainedStringBytes[distinct3](seen) + retainedStringBytes[distinct4](seen)
+	sharedBytes := retainedStringBytes[country1](seen) + retainedStringBytes[country2](seen) +
+		retainedStringBytes[country3](seen) + retainedStringBytes[country4](seen)
+
+	assert.Equal(t, 4*vocabularySize*vocabularyWidth, distinctBytes)
+	assert.Equal(t, vocabularySize*vocabularyWidth, sharedBytes)
+
+	word1 := enum.ToString(enum.MustFromString[country1](vocabularyWord("country", 42)))
+	word4 := enum.ToString(enum.MustFromString[country4](vocabularyWord("country", 42)))
+	assert.Same(t, unsafe.StringData(word1), unsafe.StringData(word4))
+
+	assertVocabulary[country1](t, "country")
+	assertVocabulary[country4](t, "country")
+	assertVocabulary[distinct1](t, "d1")
+	assertVocabulary[distinct4](t, "d4")
+}
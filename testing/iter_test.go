@@ -0,0 +1,74 @@
+//go:build go1.23
+
+package testing_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestValues(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.Map(Role(2), "user")
+		RoleAdmin = enum.Map(Role(0), "admin")
+		RoleGuest = enum.Map(Role(1), "guest")
+	)
+
+	assert.Equal(t, []Role{RoleUser, RoleAdmin, RoleGuest}, slices.Collect(enum.Values[Role]()))
+	assert.Equal(t, []Role{RoleAdmin, RoleGuest, RoleUser}, slices.Collect(enum.ValuesSorted[Role]()))
+	assert.Equal(t, []Role{RoleUser, RoleAdmin, RoleGuest}, enum.All[Role](), "ValuesSorted must not reorder All")
+}
+
+func TestValuesBreak(t *testing.T) {
+	type role any
+	type WrapRole = enum.WrapEnum[role]
+
+	var (
+		RoleUser  = enum.New[WrapRole]("user", 3)
+		RoleAdmin = enum.New[WrapRole]("admin", 1)
+		_         = enum.New[WrapRole]("guest", 2)
+	)
+
+	var got []WrapRole
+	for role := range enum.Values[WrapRole]() {
+		got = append(got, role)
+		break
+	}
+	assert.Equal(t, []WrapRole{RoleUser}, got)
+
+	got = nil
+	for role := range enum.ValuesSorted[WrapRole]() {
+		got = append(got, role)
+		break
+	}
+	assert.Equal(t, []WrapRole{RoleAdmin}, got)
+}
+
+func TestValuesEmpty(t *testing.T) {
+	type Role int
+
+	assert.Empty(t, slices.Collect(enum.Values[Role]()))
+	assert.Empty(t, slices.Collect(enum.ValuesSorted[Role]()))
+}
+
+func TestEnumerate(t *testing.T) {
+	type Role int
+
+	var (
+		_ = enum.New[Role]("user")
+		_ = enum.NewOpts[Role](enum.Str("admin"), enum.Alias("root"))
+	)
+
+	var names []string
+	for role, name := range enum.Enumerate[Role]() {
+		assert.Equal(t, enum.All[Role]()[len(names)], role)
+		assert.Equal(t, enum.ToString(role), name)
+		names = append(names, name)
+	}
+	assert.Equal(t, []string{"user", "admin"}, names)
+}
@@ -0,0 +1,82 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestJSONFormat(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		_         = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	type Account struct {
+		Role Role `json:"role"`
+	}
+
+	data, err := json.Marshal(Account{Role: RoleAdmin})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"role":"admin"}`, string(data))
+
+	var account Account
+	assert.Error(t, json.Unmarshal([]byte(`{"role":1}`), &account))
+
+	enum.SetJSONFormat[Role](enum.JSONNumber)
+	defer enum.SetJSONFormat[Role](enum.JSONString)
+
+	data, err = json.Marshal(Account{Role: RoleAdmin})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"role":1}`, string(data))
+
+	account = Account{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"role":1}`), &account))
+	assert.Equal(t, RoleAdmin, account.Role)
+
+	account = Account{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"role":"admin"}`), &account))
+	assert.Equal(t, RoleAdmin, account.Role)
+
+	assert.EqualError(t, json.Unmarshal([]byte(`{"role":7}`), &account), "enum WrapEnum[role]: unknown number 7")
+
+	_, err = json.Marshal(Account{Role: Role(7)})
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: invalid value 7")
+
+	// Text and TOML stay strings.
+	text, err := RoleAdmin.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", string(text))
+
+	toml, err := RoleAdmin.MarshalTOML()
+	assert.NoError(t, err)
+	assert.Equal(t, `"admin"`, string(toml))
+}
+
+func TestJSONFormatFloat(t *testing.T) {
+	type ratio any
+	type Ratio = enum.WrapFloatEnum[ratio]
+
+	var (
+		RatioHalf = enum.New[Ratio](0.5, "half")
+		RatioOne  = enum.New[Ratio](1.0, "one")
+	)
+
+	enum.SetJSONFormat[Ratio](enum.JSONNumber)
+
+	data, err := json.Marshal([]Ratio{RatioHalf, RatioOne})
+	assert.NoError(t, err)
+	assert.Equal(t, `[0.5,1]`, string(data))
+
+	var ratios []Ratio
+	assert.NoError(t, json.Unmarshal(data, &ratios))
+	assert.Equal(t, []Ratio{RatioHalf, RatioOne}, ratios)
+
+	assert.PanicsWithValue(t, "enum WrapFloatEnum[ratio]: unknown JSON format 9",
+		func() { enum.SetJSONFormat[Ratio](9) })
+}
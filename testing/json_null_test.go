@@ -0,0 +1,55 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func testJSONNull[Enum comparable](t *testing.T, admin Enum) {
+	type DTO struct {
+		Role Enum `json:"role"`
+	}
+
+	dto := DTO{Role: admin}
+	assert.NoError(t, json.Unmarshal([]byte(`{"role":null}`), &dto))
+
+	var zero Enum
+	assert.Equal(t, zero, dto.Role)
+
+	enum.SetJSONNullIsError[Enum](true)
+	defer enum.SetJSONNullIsError[Enum](false)
+
+	dto = DTO{Role: admin}
+	assert.ErrorContains(t, json.Unmarshal([]byte(`{"role":null}`), &dto), "invalid string null")
+}
+
+func TestJSONNull(t *testing.T) {
+	type role any
+
+	t.Run("WrapEnum", func(t *testing.T) {
+		type Role = enum.WrapEnum[role]
+		enum.New[Role]("user")
+		testJSONNull(t, enum.New[Role]("admin"))
+	})
+
+	t.Run("WrapUintEnum", func(t *testing.T) {
+		type Role = enum.WrapUintEnum[role]
+		enum.New[Role]("user")
+		testJSONNull(t, enum.New[Role]("admin"))
+	})
+
+	t.Run("WrapFloatEnum", func(t *testing.T) {
+		type Role = enum.WrapFloatEnum[role]
+		enum.New[Role]("user")
+		testJSONNull(t, enum.New[Role]("admin"))
+	})
+
+	t.Run("SafeEnum", func(t *testing.T) {
+		type Role = enum.SafeEnum[role]
+		enum.New[Role]("user")
+		testJSONNull(t, enum.New[Role]("admin"))
+	})
+}
@@ -0,0 +1,210 @@
+package testing_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+type quota struct {
+	Limit int `json:"limit"`
+}
+
+func openJSONBMapTable(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`CREATE TABLE quotas (
+		id INTEGER PRIMARY KEY,
+		quotas TEXT
+	);`)
+	assert.NoError(t, err)
+
+	return db
+}
+
+func TestJSONBMapJSON(t *testing.T) {
+	type Role int
+	type Quotas = enum.JSONBMap[Role, quota]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	type Plan struct {
+		Quotas Quotas `json:"quotas"`
+	}
+
+	data, err := json.Marshal(Plan{Quotas: Quotas{RoleUser: {Limit: 10}, RoleAdmin: {Limit: 100}}})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"quotas":{"admin":{"limit":100},"user":{"limit":10}}}`, string(data))
+
+	var plan Plan
+	assert.NoError(t, json.Unmarshal(data, &plan))
+	assert.Equal(t, Quotas{RoleUser: {Limit: 10}, RoleAdmin: {Limit: 100}}, plan.Quotas)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"quotas":null}`), &plan))
+	assert.Nil(t, plan.Quotas)
+
+	_, err = json.Marshal(Quotas{Role(42): {}})
+	assert.ErrorContains(t, err, "enum Role: invalid key 42")
+
+	err = json.Unmarshal([]byte(`{"quotas":["user"]}`), &plan)
+	assert.ErrorContains(t, err, "enum Role: JSONBMap requires a JSON object")
+
+	err = json.Unmarshal([]byte(`{"quotas":{"user":{"limit":1},"admin":"unlimited"}}`), &plan)
+	assert.ErrorContains(t, err, "enum Role: invalid value of key admin at index 1")
+}
+
+func TestJSONBMapKeyMatching(t *testing.T) {
+	type Role int
+	type Quotas = enum.JSONBMap[Role, int]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	enum.SetStringMatcher[Role](enum.MatchFoldNFC)
+	enum.SetParseOptions[Role](enum.TrimSpace())
+
+	// Keys are resolved as the values are.
+	var value Role
+	assert.NoError(t, enum.UnmarshalJSON([]byte(`" ADMIN "`), &value))
+	assert.Equal(t, RoleAdmin, value)
+
+	var quotas Quotas
+	assert.NoError(t, json.Unmarshal([]byte(`{" ADMIN ":100,"User":10}`), &quotas))
+	assert.Equal(t, Quotas{RoleAdmin: 100, RoleUser: 10}, quotas)
+
+	// The canonical strings are emitted.
+	data, err := json.Marshal(quotas)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"admin":100,"user":10}`, string(data))
+}
+
+func TestJSONBMapNilAndEmpty(t *testing.T) {
+	type Role int
+	type Quotas = enum.JSONBMap[Role, int]
+
+	var (
+		_ = enum.New[Role]("user")
+	)
+
+	data, err := json.Marshal(Quotas(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, string(data))
+
+	data, err = json.Marshal(Quotas{})
+	assert.NoError(t, err)
+	assert.Equal(t, `{}`, string(data))
+
+	value, err := Quotas(nil).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(`{}`), value)
+}
+
+func TestJSONBMapSQL(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type Quotas = enum.JSONBMap[Role, int]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	db := openJSONBMapTable(t)
+	defer db.Close()
+
+	_, err := db.Exec(`INSERT INTO quotas (quotas) VALUES (?)`, Quotas{RoleUser: 10, RoleAdmin: 100})
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`INSERT INTO quotas (quotas) VALUES (?)`, Quotas(nil))
+	assert.NoError(t, err)
+
+	var raw string
+	assert.NoError(t, db.QueryRow(`SELECT quotas FROM quotas WHERE id = 1`).Scan(&raw))
+	assert.Equal(t, `{"admin":100,"user":10}`, raw)
+
+	var quotas Quotas
+	assert.NoError(t, db.QueryRow(`SELECT quotas FROM quotas WHERE id = 1`).Scan(&quotas))
+	assert.Equal(t, Quotas{RoleUser: 10, RoleAdmin: 100}, quotas)
+
+	// A nil map is stored as an empty object.
+	assert.NoError(t, db.QueryRow(`SELECT quotas FROM quotas WHERE id = 2`).Scan(&raw))
+	assert.Equal(t, `{}`, raw)
+
+	assert.NoError(t, db.QueryRow(`SELECT quotas FROM quotas WHERE id = 2`).Scan(&quotas))
+	assert.Equal(t, Quotas{}, quotas)
+
+	assert.ErrorContains(t, quotas.Scan(42), "enum WrapEnum[role]: JSONBMap does not support type int")
+}
+
+func TestJSONBMapSQLUnknownKey(t *testing.T) {
+	type Role int
+	type Quotas = enum.JSONBMap[Role, int]
+
+	var (
+		RoleUser = enum.New[Role]("user")
+		_        = enum.New[Role]("admin")
+	)
+
+	db := openJSONBMapTable(t)
+	defer db.Close()
+
+	_, err := db.Exec(`INSERT INTO quotas (quotas) VALUES (?)`, `{"user":10,"moderator":50,"admin":100}`)
+	assert.NoError(t, err)
+
+	var quotas Quotas
+	err = db.QueryRow(`SELECT quotas FROM quotas WHERE id = 1`).Scan(&quotas)
+	assert.ErrorContains(t, err, "enum Role: unknown key moderator at index 1")
+
+	enum.SetJSONBMapLenient[Role](true)
+	assert.NoError(t, db.QueryRow(`SELECT quotas FROM quotas WHERE id = 1`).Scan(&quotas))
+	assert.Equal(t, Quotas{RoleUser: 10, enum.MustFromString[Role]("admin"): 100}, quotas)
+}
+
+func TestJSONBMapNullable(t *testing.T) {
+	type Role int
+	type Quotas = enum.JSONBMap[Role, int]
+	type NullQuotas = enum.Nullable[Quotas]
+
+	var (
+		RoleUser = enum.New[Role]("user")
+	)
+
+	db := openJSONBMapTable(t)
+	defer db.Close()
+
+	_, err := db.Exec(`INSERT INTO quotas (quotas) VALUES (?)`, NullQuotas{})
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`INSERT INTO quotas (quotas) VALUES (?)`, NullQuotas{Enum: Quotas{RoleUser: 1}, Valid: true})
+	assert.NoError(t, err)
+
+	var raw sql.NullString
+	assert.NoError(t, db.QueryRow(`SELECT quotas FROM quotas WHERE id = 1`).Scan(&raw))
+	assert.False(t, raw.Valid)
+
+	var quotas NullQuotas
+	assert.NoError(t, db.QueryRow(`SELECT quotas FROM quotas WHERE id = 1`).Scan(&quotas))
+	assert.False(t, quotas.Valid)
+
+	assert.NoError(t, db.QueryRow(`SELECT quotas FROM quotas WHERE id = 2`).Scan(&quotas))
+	assert.Equal(t, NullQuotas{Enum: Quotas{RoleUser: 1}, Valid: true}, quotas)
+
+	data, err := json.Marshal(NullQuotas{Enum: Quotas{RoleUser: 1}, Valid: true})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"user":1}`, string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"user":2}`), &quotas))
+	assert.Equal(t, NullQuotas{Enum: Quotas{RoleUser: 2}, Valid: true}, quotas)
+
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &quotas))
+	assert.False(t, quotas.Valid)
+}
@@ -0,0 +1,85 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestMarkdownTable(t *testing.T) {
+	type Role int
+
+	var (
+		_ = enum.New[Role]("admin", 5)
+		_ = enum.New[Role]("user", 1)
+	)
+
+	assert.Equal(t, ""+
+		"| Number | String |\n"+
+		"| -----: | ------ |\n"+
+		"| 1 | user |\n"+
+		"| 5 | admin |\n",
+		enum.MarkdownTable[Role]())
+}
+
+func TestMarkdownTableLargeNumbers(t *testing.T) {
+	type Code int
+	type ratio any
+	type Ratio = enum.WrapFloatEnum[ratio]
+
+	var (
+		_ = enum.New[Code]("ok", 1000000)
+		_ = enum.New[Code]("moved", 4012000)
+
+		_ = enum.New[Ratio]("half", 0.5)
+		_ = enum.New[Ratio]("huge", 2500000.5)
+	)
+
+	assert.Equal(t, ""+
+		"| Number | String |\n"+
+		"| -----: | ------ |\n"+
+		"| 1000000 | ok |\n"+
+		"| 4012000 | moved |\n",
+		enum.MarkdownTable[Code]())
+
+	assert.Equal(t, ""+
+		"| Number | String |\n"+
+		"| -----: | ------ |\n"+
+		"| 0.5 | half |\n"+
+		"| 2500000.5 | huge |\n",
+		enum.MarkdownTable[Ratio]())
+}
+
+func TestMarkdownTableSpecialCharacters(t *testing.T) {
+	type Op int
+
+	var (
+		OpOr  = enum.MapDesc(enum.New[Op]("a|b"), "either a\nor b")
+		OpAnd = enum.New[Op]("a&b")
+	)
+
+	enum.MapLegacyString(OpOr, "or")
+	enum.MapDesc(OpAnd, `both, see C:\ops`)
+
+	assert.Equal(t, ""+
+		"| Number | String | Description | Aliases |\n"+
+		"| -----: | ------ | ----------- | ------- |\n"+
+		"| 0 | a\\|b | either a<br>or b | or |\n"+
+		"| 1 | a&b | both, see C:\\\\ops |  |\n",
+		enum.MarkdownTable[Op]())
+}
+
+func TestMarkdownTableAll(t *testing.T) {
+	type MarkdownStatus int
+
+	var (
+		_ = enum.New[MarkdownStatus]("open")
+	)
+
+	assert.Contains(t, enum.MarkdownTableAll(), ""+
+		"### MarkdownStatus\n\n"+
+		"| Number | String |\n"+
+		"| -----: | ------ |\n"+
+		"| 0 | open |\n")
+}
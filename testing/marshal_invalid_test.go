@@ -0,0 +1,77 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMarshalInvalidStrict(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	enum.New[Role]("user")
+
+	type DTO struct {
+		Name string `json:"name"`
+		Role Role   `json:"role"`
+	}
+
+	_, err := json.Marshal(DTO{Name: "tester", Role: Role(5)})
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: invalid value 5")
+
+	_, err = yaml.Marshal(DTO{Name: "tester", Role: Role(5)})
+	assert.Error(t, err)
+}
+
+func TestMarshalInvalidAsNull(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	RoleUser := enum.New[Role]("user")
+	enum.SetMarshalInvalidAsNull[Role](true)
+
+	type DTO struct {
+		Name string `json:"name" yaml:"name"`
+		Role Role   `json:"role" yaml:"role"`
+	}
+
+	data, err := json.Marshal(DTO{Name: "tester", Role: Role(5)})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"tester","role":null}`, string(data))
+
+	data, err = json.Marshal(DTO{Name: "tester", Role: RoleUser})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"tester","role":"user"}`, string(data))
+
+	data, err = yaml.Marshal(DTO{Name: "tester", Role: Role(5)})
+	assert.NoError(t, err)
+	assert.Equal(t, "name: tester\nrole: null\n", string(data))
+
+	// Other formats stay strict.
+	_, err = Role(5).MarshalText()
+	assert.Error(t, err)
+
+	enum.SetMarshalInvalidAsNull[Role](false)
+	_, err = json.Marshal(DTO{Name: "tester", Role: Role(5)})
+	assert.Error(t, err)
+}
+
+func TestMarshalInvalidPlaceholder(t *testing.T) {
+	type role any
+	type Role = enum.WrapUintEnum[role]
+
+	enum.New[Role]("user")
+	enum.SetMarshalInvalidAsNull[Role](true, enum.WithPlaceholder("unknown"))
+
+	data, err := json.Marshal(map[string]Role{"role": Role(5)})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"role":"unknown"}`, string(data))
+
+	data, err = yaml.Marshal(map[string]Role{"role": Role(5)})
+	assert.NoError(t, err)
+	assert.Equal(t, "role: unknown\n", string(data))
+}
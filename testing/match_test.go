@@ -0,0 +1,201 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+const (
+	cafeNFC = "Café"  // Café, precomposed.
+	cafeNFD = "Café" // Café, decomposed.
+)
+
+func TestStringMatcherExact(t *testing.T) {
+	type brand any
+	type Brand = enum.WrapEnum[brand]
+
+	enum.New[Brand](cafeNFC)
+
+	_, ok := enum.FromString[Brand](cafeNFD)
+	assert.False(t, ok)
+}
+
+func TestStringMatcherNFC(t *testing.T) {
+	type brand any
+	type Brand = enum.WrapEnum[brand]
+
+	var (
+		BrandCafe   = enum.New[Brand](cafeNFC)
+		BrandNestle = enum.New[Brand]("Néstle") // Registered decomposed.
+		BrandPho    = enum.New[Brand]("Phở")     // Phở, precomposed.
+	)
+
+	enum.SetStringMatcher[Brand](enum.MatchNFC)
+
+	for input, want := range map[string]Brand{
+		cafeNFC:   BrandCafe,
+		cafeNFD:   BrandCafe,
+		"Néstle":  BrandNestle,
+		"Néstle": BrandNestle,
+		"Phở":   BrandPho, // o + horn + hook above.
+		"Phở":    BrandPho, // ơ + hook above.
+		"Phở":     BrandPho,
+	} {
+		value, ok := enum.FromString[Brand](input)
+		assert.True(t, ok, "%q", input)
+		assert.Equal(t, want, value, "%q", input)
+	}
+
+	_, ok := enum.FromString[Brand]("café")
+	assert.False(t, ok, "case is not ignored")
+
+	// Deserialization goes through the matcher, serialization emits the
+	// registered bytes.
+	var b Brand
+	assert.NoError(t, json.Unmarshal([]byte(`"Café"`), &b))
+	assert.Equal(t, BrandCafe, b)
+
+	data, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.Equal(t, `"`+cafeNFC+`"`, string(data))
+
+	assert.NoError(t, b.UnmarshalText([]byte("Néstle")))
+	text, err := b.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "Néstle", string(text))
+
+	assert.NoError(t, b.Scan(cafeNFD))
+	assert.Equal(t, BrandCafe, b)
+}
+
+func TestStringMatcherFoldNFC(t *testing.T) {
+	type brand any
+	type Brand = enum.WrapEnum[brand]
+
+	BrandCafe := enum.New[Brand](cafeNFC)
+
+	enum.SetStringMatcher[Brand](enum.MatchFoldNFC)
+
+	for _, input := range []string{cafeNFC, cafeNFD, "CAFÉ", "cafÉ", "cAfé"} {
+		value, ok := enum.FromString[Brand](input)
+		assert.True(t, ok, "%q", input)
+		assert.Equal(t, BrandCafe, value, "%q", input)
+	}
+
+	// Values mapped after the matcher is set are indexed too.
+	BrandKelvin := enum.New[Brand]("kelvin")
+	value, ok := enum.FromString[Brand]("KELVIN") // Kelvin sign.
+	assert.True(t, ok)
+	assert.Equal(t, BrandKelvin, value)
+
+	// Back to exact matching.
+	enum.SetStringMatcher[Brand](enum.MatchExact)
+	_, ok = enum.FromString[Brand](cafeNFD)
+	assert.False(t, ok)
+
+	value, ok = enum.FromString[Brand](cafeNFC)
+	assert.True(t, ok)
+	assert.Equal(t, BrandCafe, value)
+}
+
+func TestStringMatcherCollisions(t *testing.T) {
+	type brand any
+	type Brand = enum.WrapEnum[brand]
+
+	enum.New[Brand](cafeNFC)
+	enum.New[Brand](cafeNFD)
+
+	assert.PanicsWithValue(t,
+		"enum WrapEnum[brand]: strings "+cafeNFC+" and "+cafeNFD+" collide under the string matcher",
+		func() { enum.SetStringMatcher[Brand](enum.MatchNFC) })
+
+	type label any
+	type Label = enum.WrapEnum[label]
+
+	enum.New[Label]("Beta")
+	enum.SetStringMatcher[Label](enum.MatchFoldNFC)
+
+	assert.PanicsWithValue(t,
+		"enum WrapEnum[label] (1): string BETA collides with string Beta of Beta under the string matcher",
+		func() { enum.New[Label]("BETA") })
+}
+
+func TestStringMatcherFinalized(t *testing.T) {
+	type brand any
+	type Brand = enum.WrapEnum[brand]
+
+	enum.New[Brand](cafeNFC)
+	enum.Finalize[Brand]()
+
+	assert.PanicsWithValue(t, "enum WrapEnum[brand]: the enum was already finalized",
+		func() { enum.SetStringMatcher[Brand](enum.MatchNFC) })
+}
+
+func TestParseOptions(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type status any
+	type Status = enum.WrapEnum[status]
+
+	var (
+		RoleAdmin    = enum.New[Role]("admin")
+		RoleReadOnly = enum.New[Role]("read_only")
+		StatusActive = enum.New[Status]("active")
+	)
+
+	enum.SetParseOptions[Role](enum.TrimSpace(), enum.CaseInsensitive(), enum.ReplaceRunes(map[rune]rune{'-': '_'}))
+
+	var got Role
+	assert.NoError(t, json.Unmarshal([]byte(`" Admin "`), &got))
+	assert.Equal(t, RoleAdmin, got)
+	assert.Equal(t, RoleReadOnly, enum.MustFromString[Role]("Read-Only\n"))
+	assert.NoError(t, got.Scan("ADMIN"))
+	assert.Equal(t, RoleAdmin, got)
+
+	data, err := json.Marshal(RoleAdmin)
+	assert.NoError(t, err)
+	assert.Equal(t, `"admin"`, string(data))
+
+	var gotStatus Status
+	assert.Error(t, json.Unmarshal([]byte(`" Active "`), &gotStatus))
+	assert.Equal(t, StatusActive, enum.MustFromString[Status]("active"))
+	_, ok := enum.FromString[Status]("ACTIVE")
+	assert.False(t, ok)
+
+	// Without option, inputs must match exactly again.
+	enum.SetParseOptions[Role]()
+	_, ok = enum.FromString[Role](" admin ")
+	assert.False(t, ok)
+}
+
+func TestParseOptionsWithStringMatcher(t *testing.T) {
+	type brand any
+	type Brand = enum.WrapEnum[brand]
+
+	BrandCafe := enum.New[Brand](cafeNFC)
+
+	enum.SetStringMatcher[Brand](enum.MatchNFC)
+	enum.SetParseOptions[Brand](enum.TrimSpace())
+
+	got, ok := enum.FromString[Brand](" " + cafeNFD + " ")
+	assert.True(t, ok)
+	assert.Equal(t, BrandCafe, got)
+}
+
+func TestParseOptionsCollisions(t *testing.T) {
+	type Role int
+
+	var (
+		_ = enum.New[Role]("admin")
+		_ = enum.New[Role]("ADMIN")
+	)
+
+	assert.PanicsWithValue(t, "enum Role: strings admin and ADMIN collide under the string matcher",
+		func() { enum.SetParseOptions[Role](enum.CaseInsensitive()) })
+
+	_, ok := enum.FromString[Role]("Admin")
+	assert.False(t, ok)
+}
@@ -0,0 +1,65 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestMust(t *testing.T) {
+	type Role int
+
+	RoleAdmin := enum.New[Role]("admin")
+
+	assert.Equal(t, RoleAdmin, enum.Must(enum.FromString[Role]("admin")))
+	assert.Equal(t, "admin", enum.Must(enum.To[string](RoleAdmin)))
+
+	assert.PanicsWithValue(t, "enum Role: unexpected failure (got 0)", func() {
+		enum.Must(enum.FromString[Role]("moderator"))
+	})
+	assert.PanicsWithValue(t, `enum string: unexpected failure (got "")`, func() {
+		enum.Must(enum.To[string](Role(42)))
+	})
+}
+
+func TestMustOK(t *testing.T) {
+	type Role int
+
+	RoleAdmin := enum.New[Role]("admin")
+
+	assert.Equal(t, "admin", enum.MustOK(enum.ToStringSafe(RoleAdmin)))
+	assert.PanicsWithValue(t, "enum Role: invalid value 42", func() {
+		enum.MustOK(enum.ToStringSafe(Role(42)))
+	})
+}
+
+func TestStrictFrom(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	assert.Equal(t, RoleAdmin, enum.StrictFromString[Role]("admin"))
+	assert.Equal(t, RoleAdmin, enum.StrictFromNumber[Role](1))
+	assert.Equal(t, RoleUser, enum.StrictFrom[Role]([]byte("user")))
+
+	assert.Equal(t, RoleUser, enum.MustFromString[Role]("admni"), "MustFromString returns the zero value")
+	assert.PanicsWithValue(t, "enum Role: unknown string admni (allowed values: user, admin)", func() {
+		enum.StrictFromString[Role]("admni")
+	})
+	assert.PanicsWithValue(t, "enum Role: unknown number 42 (allowed values: user, admin)", func() {
+		enum.StrictFromNumber[Role](42)
+	})
+	assert.PanicsWithValue(t, "enum Role: unknown string admni (allowed values: user, admin)", func() {
+		enum.StrictFrom[Role]([]byte("admni"))
+	})
+	assert.PanicsWithValue(t, "enum Role: unknown number 4.2 (allowed values: user, admin)", func() {
+		enum.StrictFrom[Role](4.2)
+	})
+	assert.PanicsWithValue(t, "enum Role: unknown representation true (allowed values: user, admin)", func() {
+		enum.StrictFrom[Role](true)
+	})
+}
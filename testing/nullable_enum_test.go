@@ -1,8 +1,13 @@
 package testing_test
 
 import (
+	"bytes"
 	"database/sql"
+	"encoding/csv"
 	"encoding/json"
+	"encoding/xml"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -209,3 +214,403 @@ func TestNullableYAMLNull(t *testing.T) {
 	assert.NoError(t, err)
 	assert.False(t, s.Role.Valid)
 }
+
+func TestNullableEqual(t *testing.T) {
+	type Role int
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	testcases := []struct {
+		name  string
+		a, b  NullRole
+		equal bool
+	}{
+		{"valid-equal", NullRole{Enum: RoleUser, Valid: true}, NullRole{Enum: RoleUser, Valid: true}, true},
+		{"valid-unequal", NullRole{Enum: RoleUser, Valid: true}, NullRole{Enum: RoleAdmin, Valid: true}, false},
+		{"valid-invalid-same-enum", NullRole{Enum: RoleUser, Valid: true}, NullRole{Enum: RoleUser}, false},
+		{"valid-invalid-different-enum", NullRole{Enum: RoleUser, Valid: true}, NullRole{Enum: RoleAdmin}, false},
+		{"invalid-valid-same-enum", NullRole{Enum: RoleUser}, NullRole{Enum: RoleUser, Valid: true}, false},
+		{"invalid-valid-different-enum", NullRole{Enum: RoleAdmin}, NullRole{Enum: RoleUser, Valid: true}, false},
+		{"invalid-same-enum", NullRole{Enum: RoleUser}, NullRole{Enum: RoleUser}, true},
+		{"invalid-different-enum", NullRole{Enum: RoleUser}, NullRole{Enum: RoleAdmin}, true},
+		{"zero", NullRole{}, NullRole{}, true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.equal, tc.a.Equal(tc.b))
+			assert.Equal(t, tc.equal, tc.b.Equal(tc.a))
+		})
+	}
+}
+
+func TestNullableEqualValue(t *testing.T) {
+	type role any
+	type Role = enum.SafeEnum[role]
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	testcases := []struct {
+		name  string
+		n     NullRole
+		value Role
+		equal bool
+	}{
+		{"valid-equal", NullRole{Enum: RoleUser, Valid: true}, RoleUser, true},
+		{"valid-unequal", NullRole{Enum: RoleUser, Valid: true}, RoleAdmin, false},
+		{"invalid-same-enum", NullRole{Enum: RoleUser}, RoleUser, false},
+		{"invalid-different-enum", NullRole{Enum: RoleUser}, RoleAdmin, false},
+		{"invalid-zero", NullRole{}, Role{}, false},
+		{"valid-zero", NullRole{Valid: true}, Role{}, true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.equal, tc.n.EqualValue(tc.value))
+		})
+	}
+}
+
+func TestNullableEqualUncomparable(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type Roles = enum.SliceSerde[Role]
+	type NullRoles = enum.Nullable[Roles]
+	type NullQuotas = enum.Nullable[enum.JSONBMap[Role, int]]
+	type NullCSVRoles = enum.Nullable[enum.CSVSlice[Role]]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	testcases := []struct {
+		name  string
+		a, b  NullRoles
+		equal bool
+	}{
+		{"valid-equal", enum.Some(Roles{RoleUser, RoleAdmin}), enum.Some(Roles{RoleUser, RoleAdmin}), true},
+		{"valid-unequal", enum.Some(Roles{RoleUser, RoleAdmin}), enum.Some(Roles{RoleAdmin, RoleUser}), false},
+		{"valid-invalid", enum.Some(Roles{RoleUser}), NullRoles{Enum: Roles{RoleUser}}, false},
+		{"invalid-different-enum", NullRoles{Enum: Roles{RoleUser}}, NullRoles{Enum: Roles{RoleAdmin}}, true},
+		{"zero", NullRoles{}, NullRoles{}, true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.equal, tc.a.Equal(tc.b))
+			assert.Equal(t, tc.equal, tc.b.Equal(tc.a))
+		})
+	}
+
+	assert.True(t, enum.Some(Roles{RoleUser}).EqualValue(Roles{RoleUser}))
+	assert.False(t, enum.Some(Roles{RoleUser}).EqualValue(Roles{RoleAdmin}))
+	assert.False(t, NullRoles{Enum: Roles{RoleUser}}.EqualValue(Roles{RoleUser}))
+
+	quotas := enum.JSONBMap[Role, int]{RoleUser: 10}
+	assert.True(t, enum.Some(quotas).Equal(NullQuotas{Enum: enum.JSONBMap[Role, int]{RoleUser: 10}, Valid: true}))
+	assert.False(t, enum.Some(quotas).EqualValue(enum.JSONBMap[Role, int]{RoleUser: 20}))
+
+	assert.True(t, enum.Some(enum.CSVSlice[Role]{RoleAdmin}).Equal(NullCSVRoles{Enum: enum.CSVSlice[Role]{RoleAdmin}, Valid: true}))
+	assert.False(t, enum.Some(enum.CSVSlice[Role]{RoleAdmin}).EqualValue(enum.CSVSlice[Role]{RoleUser}))
+}
+
+func TestNullableOrElse(t *testing.T) {
+	type Role int
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	assert.Equal(t, RoleAdmin, NullRole{Enum: RoleAdmin, Valid: true}.OrElse(RoleUser))
+	assert.Equal(t, RoleUser, NullRole{Enum: RoleAdmin}.OrElse(RoleUser))
+	assert.Equal(t, RoleUser, NullRole{}.OrElse(RoleUser))
+}
+
+func TestNullableMap(t *testing.T) {
+	type Role int
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	called := 0
+	promote := func(Role) Role {
+		called++
+		return RoleAdmin
+	}
+
+	assert.Equal(t, NullRole{Enum: RoleAdmin, Valid: true}, NullRole{Enum: RoleUser, Valid: true}.Map(promote))
+	assert.Equal(t, 1, called)
+
+	assert.Equal(t, NullRole{}, NullRole{Enum: RoleUser}.Map(promote))
+	assert.Equal(t, NullRole{}, NullRole{}.Map(promote))
+	assert.Equal(t, 1, called)
+}
+
+func TestCoalesceNullable(t *testing.T) {
+	type Role int
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	assert.Equal(t, NullRole{}, enum.CoalesceNullable[Role]())
+	assert.Equal(t, NullRole{}, enum.CoalesceNullable(NullRole{}, NullRole{Enum: RoleAdmin}))
+	assert.Equal(t, NullRole{Enum: RoleUser, Valid: true},
+		enum.CoalesceNullable(NullRole{Enum: RoleAdmin}, NullRole{Enum: RoleUser, Valid: true}, NullRole{Enum: RoleAdmin, Valid: true}))
+	assert.Equal(t, NullRole{Enum: RoleAdmin, Valid: true},
+		enum.CoalesceNullable(NullRole{Enum: RoleAdmin, Valid: true}, NullRole{Enum: RoleUser, Valid: true}))
+}
+
+func TestNullableXML(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser = enum.New[Role]("user")
+	)
+
+	type TestXML struct {
+		ID    int      `xml:"id"`
+		Role  NullRole `xml:"role"`
+		Owner NullRole `xml:"owner,attr"`
+	}
+
+	data, err := xml.Marshal(TestXML{ID: 1, Role: NullRole{Enum: RoleUser, Valid: true}, Owner: NullRole{Enum: RoleUser, Valid: true}})
+	assert.NoError(t, err)
+	assert.Equal(t, `<TestXML owner="user"><id>1</id><role>user</role></TestXML>`, string(data))
+
+	data, err = xml.Marshal(TestXML{ID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, `<TestXML owner=""><id>1</id><role></role></TestXML>`, string(data))
+
+	var s TestXML
+	assert.NoError(t, xml.Unmarshal([]byte(`<TestXML owner="user"><id>1</id><role>user</role></TestXML>`), &s))
+	assert.Equal(t, NullRole{Enum: RoleUser, Valid: true}, s.Role)
+	assert.Equal(t, NullRole{Enum: RoleUser, Valid: true}, s.Owner)
+
+	s = TestXML{}
+	assert.NoError(t, xml.Unmarshal([]byte(`<TestXML owner=""><id>1</id><role></role></TestXML>`), &s))
+	assert.False(t, s.Role.Valid)
+	assert.False(t, s.Owner.Valid)
+
+	s = TestXML{}
+	assert.NoError(t, xml.Unmarshal([]byte(`<TestXML><id>1</id><role/></TestXML>`), &s))
+	assert.False(t, s.Role.Valid)
+
+	s = TestXML{}
+	assert.NoError(t, xml.Unmarshal([]byte(`<TestXML xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><id>1</id><role xsi:nil="true"/></TestXML>`), &s))
+	assert.False(t, s.Role.Valid)
+
+	err = xml.Unmarshal([]byte(`<TestXML><id>1</id><role>guest</role></TestXML>`), &s)
+	assert.ErrorContains(t, err, "unknown string guest")
+
+	err = xml.Unmarshal([]byte(`<TestXML owner="guest"><id>1</id></TestXML>`), &s)
+	assert.ErrorContains(t, err, "unknown string guest")
+}
+
+// xmlCode is an enum with its own XML format, a "code-" prefixed string.
+type xmlCode int
+
+func (c xmlCode) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return encoder.EncodeElement("code-"+enum.ToString(c), start)
+}
+
+func (c *xmlCode) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := decoder.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+
+	return enum.UnmarshalText([]byte(strings.TrimPrefix(str, "code-")), c)
+}
+
+func (c xmlCode) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: "code-" + enum.ToString(c)}, nil
+}
+
+func (c *xmlCode) UnmarshalXMLAttr(attr xml.Attr) error {
+	return enum.UnmarshalText([]byte(strings.TrimPrefix(attr.Value, "code-")), c)
+}
+
+func TestNullableXMLDelegation(t *testing.T) {
+	type NullCode = enum.Nullable[xmlCode]
+
+	var (
+		CodeOK = enum.New[xmlCode]("ok")
+	)
+
+	type TestXML struct {
+		Code  NullCode `xml:"code"`
+		Owner NullCode `xml:"owner,attr"`
+	}
+
+	data, err := xml.Marshal(TestXML{Code: enum.Some(CodeOK), Owner: enum.Some(CodeOK)})
+	assert.NoError(t, err)
+	assert.Equal(t, `<TestXML owner="code-ok"><code>code-ok</code></TestXML>`, string(data))
+
+	var s TestXML
+	assert.NoError(t, xml.Unmarshal(data, &s))
+	assert.Equal(t, enum.Some(CodeOK), s.Code)
+	assert.Equal(t, enum.Some(CodeOK), s.Owner)
+
+	s = TestXML{}
+	assert.NoError(t, xml.Unmarshal([]byte(`<TestXML owner=""><code></code></TestXML>`), &s))
+	assert.False(t, s.Code.Valid)
+	assert.False(t, s.Owner.Valid)
+
+	err = xml.Unmarshal([]byte(`<TestXML><code>code-bad</code></TestXML>`), &s)
+	assert.ErrorContains(t, err, "unknown string bad")
+}
+
+func TestNullableText(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	rows := []NullRole{{Enum: RoleUser, Valid: true}, {}, {Enum: RoleAdmin, Valid: true}}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	for i, r := range rows {
+		text, err := r.MarshalText()
+		assert.NoError(t, err)
+		assert.NoError(t, w.Write([]string{strconv.Itoa(i), string(text)}))
+	}
+	w.Flush()
+	assert.Equal(t, "0,user\n1,\n2,admin\n", buf.String())
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	assert.NoError(t, err)
+
+	var decoded []NullRole
+	for _, record := range records {
+		var r NullRole
+		assert.NoError(t, r.UnmarshalText([]byte(record[1])))
+		decoded = append(decoded, r)
+	}
+	assert.Equal(t, rows, decoded)
+
+	// Nullable can be used as a JSON map key through encoding.TextMarshaler.
+	data, err := json.Marshal(map[NullRole]int{{Enum: RoleUser, Valid: true}: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"user":1}`, string(data))
+
+	var r NullRole
+	err = r.UnmarshalText([]byte("guest"))
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: unknown string guest")
+	assert.False(t, r.Valid)
+}
+
+func TestNullableConstructors(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser = enum.New[Role]("user")
+	)
+
+	assert.Equal(t, NullRole{}, enum.Null[Role]())
+	assert.Equal(t, NullRole{Enum: RoleUser, Valid: true}, enum.Some(RoleUser))
+
+	value, ok := enum.Some(RoleUser).Get()
+	assert.True(t, ok)
+	assert.Equal(t, RoleUser, value)
+
+	_, ok = enum.Null[Role]().Get()
+	assert.False(t, ok)
+
+	assert.Equal(t, RoleUser, enum.Some(RoleUser).MustGet())
+	assert.PanicsWithValue(t, "enum WrapEnum[role]: get the value of a null enum", func() {
+		enum.Null[Role]().MustGet()
+	})
+
+	type TestJSON struct {
+		Role NullRole `json:"role"`
+	}
+
+	for _, tc := range []struct {
+		constructed NullRole
+		literal     NullRole
+	}{
+		{enum.Some(RoleUser), NullRole{Enum: RoleUser, Valid: true}},
+		{enum.Null[Role](), NullRole{}},
+	} {
+		data, err := json.Marshal(TestJSON{Role: tc.constructed})
+		assert.NoError(t, err)
+
+		expected, err := json.Marshal(TestJSON{Role: tc.literal})
+		assert.NoError(t, err)
+		assert.Equal(t, string(expected), string(data))
+
+		var decoded TestJSON
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, tc.literal, decoded.Role)
+	}
+}
+
+func TestNullablePtr(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser = enum.New[Role]("user")
+	)
+
+	assert.Equal(t, enum.Some(RoleUser), enum.NullableFromPtr(&RoleUser))
+	assert.Equal(t, enum.Null[Role](), enum.NullableFromPtr[Role](nil))
+
+	// Invalid enums pass through, only nil-ness is checked.
+	invalid := Role(42)
+	assert.Equal(t, NullRole{Enum: invalid, Valid: true}, enum.NullableFromPtr(&invalid))
+
+	ptr := enum.Some(RoleUser).Ptr()
+	if assert.NotNil(t, ptr) {
+		assert.Equal(t, RoleUser, *ptr)
+	}
+	assert.Nil(t, enum.Null[Role]().Ptr())
+
+	// Ptr returns a pointer to a copy.
+	n := enum.Some(RoleUser)
+	*n.Ptr() = invalid
+	assert.Equal(t, RoleUser, n.Enum)
+
+	data, err := json.Marshal(enum.NullableFromPtr(&RoleUser))
+	assert.NoError(t, err)
+	assert.Equal(t, `"user"`, string(data))
+
+	data, err = json.Marshal(enum.NullableFromPtr[Role](nil))
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	data, err = json.Marshal(enum.Some(RoleUser).Ptr())
+	assert.NoError(t, err)
+	assert.Equal(t, `"user"`, string(data))
+
+	data, err = json.Marshal(enum.Null[Role]().Ptr())
+	assert.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+}
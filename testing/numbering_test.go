@@ -0,0 +1,111 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestMixedNumberingExplicitFirst(t *testing.T) {
+	type Role int
+
+	var (
+		RoleX = enum.Map(Role(1), "x")
+		RoleY = enum.New[Role]("y")
+		RoleZ = enum.New[Role]("z")
+	)
+
+	// Auto-assignment skips the explicit numbers.
+	assert.Equal(t, Role(1), RoleX)
+	assert.Equal(t, Role(0), RoleY)
+	assert.Equal(t, Role(2), RoleZ)
+}
+
+func TestMixedNumberingAutoFirst(t *testing.T) {
+	type Role int
+
+	var (
+		_ = enum.New[Role]("y")
+		_ = enum.New[Role]("z")
+	)
+
+	assert.PanicsWithValue(t,
+		"enum Role (1): number 1 was auto-assigned to z by enum.New; pass an explicit number or reorder registrations",
+		func() { enum.Map(Role(1), "x") })
+}
+
+func TestMixedNumberingAutoFirstWrapEnum(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var _ = enum.New[Role]("y")
+
+	assert.PanicsWithValue(t,
+		"enum WrapEnum[role] (y): number 0 was auto-assigned to y by enum.New; pass an explicit number or reorder registrations",
+		func() { enum.New[Role](0, "x") })
+}
+
+func TestNumberConflictExplicit(t *testing.T) {
+	type Role int
+
+	var _ = enum.Map(Role(1), "y")
+
+	assert.PanicsWithValue(t, "enum Role (1): do not map number twice",
+		func() { enum.Map(Role(1), "x") })
+}
+
+func TestRequireSingleNumberingStyle(t *testing.T) {
+	type Role int
+
+	enum.RequireSingleNumberingStyle[Role]()
+
+	var (
+		_ = enum.New[Role]("y")
+		_ = enum.New[Role]("z")
+	)
+
+	assert.PanicsWithValue(t,
+		"enum Role (5): cannot mix auto-assigned and explicit numbers (see RequireSingleNumberingStyle)",
+		func() { enum.Map(Role(5), "x") })
+
+	_, ok := enum.FromString[Role]("x")
+	assert.False(t, ok)
+}
+
+func TestRequireSingleNumberingStyleExplicitFirst(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	enum.RequireSingleNumberingStyle[Role]()
+
+	var _ = enum.New[Role](5, "x")
+
+	assert.PanicsWithValue(t,
+		"enum WrapEnum[role] (0): cannot mix auto-assigned and explicit numbers (see RequireSingleNumberingStyle)",
+		func() { enum.New[Role]("y") })
+}
+
+func TestRequireSingleNumberingStyleAlreadyMixed(t *testing.T) {
+	type Role int
+
+	var (
+		_ = enum.Map(Role(5), "x")
+		_ = enum.New[Role]("y")
+	)
+
+	assert.PanicsWithValue(t, "enum Role: auto-assigned and explicit numbers were already mixed",
+		func() { enum.RequireSingleNumberingStyle[Role]() })
+}
+
+func TestRequireSingleNumberingStyleSafeEnum(t *testing.T) {
+	type role any
+	type Role = enum.SafeEnum[role]
+
+	enum.RequireSingleNumberingStyle[Role]()
+
+	assert.NotPanics(t, func() {
+		enum.New[Role]("user")
+		enum.New[Role]("admin")
+	})
+}
@@ -0,0 +1,59 @@
+//go:build go1.24
+
+package testing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestNullableOmitZero(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser = enum.New[Role]("user")
+	)
+
+	type User struct {
+		ID   int      `json:"id"`
+		Role NullRole `json:"role,omitzero"`
+	}
+
+	data, err := json.Marshal(User{ID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":1}`, string(data))
+
+	data, err = json.Marshal(User{ID: 1, Role: NullRole{Enum: RoleUser, Valid: true}})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":1,"role":"user"}`, string(data))
+}
+
+func TestSafeEnumOmitZero(t *testing.T) {
+	type role string
+	type Role = enum.SafeEnum[role]
+
+	var (
+		RoleUser = enum.New[Role]("user")
+	)
+
+	type User struct {
+		ID   int  `json:"id"`
+		Role Role `json:"role,omitzero"`
+	}
+
+	assert.True(t, Role{}.IsZero())
+	assert.False(t, RoleUser.IsZero())
+
+	data, err := json.Marshal(User{ID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":1}`, string(data))
+
+	data, err = json.Marshal(User{ID: 1, Role: RoleUser})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":1,"role":"user"}`, string(data))
+}
@@ -0,0 +1,139 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/swaggest/jsonschema-go"
+	"github.com/xybor-x/enum"
+)
+
+func TestOpenAPIEnum(t *testing.T) {
+	type Role int
+
+	var (
+		_ = enum.New[Role]("user")
+		_ = enum.New[Role]("admin")
+	)
+
+	assert.Equal(t, []any{"user", "admin"}, enum.OpenAPIEnum[Role]())
+
+	// Values registered later are reflected.
+	enum.New[Role]("guest")
+	assert.Equal(t, []any{"user", "admin", "guest"}, enum.OpenAPIEnum[Role]())
+
+	type Level int
+
+	var (
+		_ = enum.New[Level]("low", 1)
+		_ = enum.New[Level]("high", 10)
+	)
+
+	enum.SetJSONFormat[Level](enum.JSONNumber)
+	assert.Equal(t, []any{float64(1), float64(10)}, enum.OpenAPIEnum[Level]())
+}
+
+func TestOpenAPIReflector(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type status any
+	type Status = enum.SafeEnum[status]
+
+	var (
+		_ = enum.New[Role]("user")
+		_ = enum.New[Role]("admin")
+		_ = enum.New[Status]("active")
+		_ = enum.New[Status]("closed")
+	)
+
+	type User struct {
+		Role   Role   `json:"role"`
+		Status Status `json:"status"`
+	}
+
+	reflector := jsonschema.Reflector{}
+	schema, err := reflector.Reflect(User{}, jsonschema.InlineRefs)
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(schema)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"properties": {
+			"role": {"type": "string", "enum": ["user", "admin"]},
+			"status": {"type": "string", "enum": ["active", "closed"]}
+		},
+		"type": "object"
+	}`, string(data))
+}
+
+func TestOpenAPIReflectorJSONNumber(t *testing.T) {
+	type level any
+	type Level = enum.WrapEnum[level]
+
+	var (
+		_ = enum.New[Level]("low", 1)
+		_ = enum.New[Level]("high", 10)
+	)
+
+	enum.SetJSONFormat[Level](enum.JSONNumber)
+
+	type Alert struct {
+		Level Level `json:"level"`
+	}
+
+	reflector := jsonschema.Reflector{}
+	schema, err := reflector.Reflect(Alert{}, jsonschema.InlineRefs)
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(schema)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"properties": {"level": {"type": "integer", "enum": [1, 10]}},
+		"type": "object"
+	}`, string(data))
+}
+
+func TestOpenAPIReflectorDescriptions(t *testing.T) {
+	type level any
+	type Level = enum.WrapFloatEnum[level]
+
+	var (
+		LevelLow = enum.New[Level]("low", 1.0)
+		_        = enum.New[Level]("mid", 2.5)
+	)
+
+	enum.MapDesc(LevelLow, "can wait")
+
+	type Alert struct {
+		Level Level `json:"level"`
+	}
+
+	reflector := jsonschema.Reflector{}
+	schema, err := reflector.Reflect(Alert{}, jsonschema.InlineRefs)
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(schema)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"properties": {"level": {"type": "string", "enum": ["low", "mid"], "x-enum-descriptions": ["can wait", "mid"]}},
+		"type": "object"
+	}`, string(data))
+
+	// SchemaOf and the reflected schema agree on the JSON format.
+	enum.SetJSONFormat[Level](enum.JSONNumber)
+
+	schema, err = reflector.Reflect(Alert{}, jsonschema.InlineRefs)
+	assert.NoError(t, err)
+
+	data, err = json.Marshal(schema)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"properties": {"level": {"type": "number", "enum": [1, 2.5], "x-enum-descriptions": ["can wait", "mid"]}},
+		"type": "object"
+	}`, string(data))
+
+	data, err = json.Marshal(enum.SchemaOf[Level]())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type": "number", "enum": [1, 2.5], "x-enum-descriptions": ["can wait", "mid"]}`, string(data))
+}
@@ -0,0 +1,192 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+// optionProtoRole mimics a generated protobuf enum.
+type optionProtoRole int32
+
+func (r optionProtoRole) String() string {
+	return fmt.Sprintf("PROTO_ROLE_%d", int32(r))
+}
+
+func TestMapOpts(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser  = enum.MapOpts(Role(0), enum.Str("user"), enum.Repr(optionProtoRole(1)))
+		RoleAdmin = enum.MapOpts(Role(1), enum.Str("admin"), enum.Repr(optionProtoRole(2)),
+			enum.Alias("administrator"), enum.Alias("root"))
+	)
+
+	assert.Equal(t, "admin", enum.ToString(RoleAdmin))
+	assert.Equal(t, optionProtoRole(1), enum.MustTo[optionProtoRole](RoleUser))
+	assert.Equal(t, RoleAdmin, enum.MustFrom[Role](optionProtoRole(2)))
+
+	for _, s := range []string{"admin", "administrator", "root"} {
+		value, ok := enum.FromString[Role](s)
+		assert.True(t, ok, s)
+		assert.Equal(t, RoleAdmin, value, s)
+	}
+
+	// Aliases are never emitted.
+	assert.Equal(t, []Role{RoleUser, RoleAdmin}, enum.All[Role]())
+	data, err := enum.MarshalJSON(enum.MustFromString[Role]("root"))
+	assert.NoError(t, err)
+	assert.Equal(t, `"admin"`, string(data))
+}
+
+func TestNewOpts(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type SafeRole = enum.SafeEnum[role]
+
+	var (
+		RoleUser  = enum.NewOpts[Role](enum.Str("user"))
+		RoleAdmin = enum.NewOpts[Role](enum.Str("admin"), enum.Num(5))
+
+		SafeRoleUser = enum.NewOpts[SafeRole](enum.Str("user"), enum.Num(3), enum.Alias("member"))
+	)
+
+	assert.Equal(t, Role(0), RoleUser)
+	assert.Equal(t, Role(5), RoleAdmin)
+	assert.Equal(t, "admin", RoleAdmin.String())
+
+	assert.Equal(t, "user", SafeRoleUser.String())
+	assert.Equal(t, 3, SafeRoleUser.IntOr(-1))
+
+	var s SafeRole
+	assert.NoError(t, json.Unmarshal([]byte(`"member"`), &s))
+	assert.Equal(t, SafeRoleUser, s)
+
+	// A custom number is never used as the number, unlike with New.
+	SafeRoleProto := enum.NewOpts[SafeRole](enum.Str("proto"), enum.Repr(optionProtoRole(7)))
+	assert.Equal(t, 0, SafeRoleProto.IntOr(-1))
+	assert.Equal(t, optionProtoRole(7), enum.MustTo[optionProtoRole](SafeRoleProto))
+
+	// A Stringer is never used as the string.
+	assert.PanicsWithValue(t, "SafeEnum requires at least a string representation",
+		func() { enum.NewOpts[SafeRole](enum.Repr(optionProtoRole(8))) })
+}
+
+func TestNewExtendedOpts(t *testing.T) {
+	type role any
+	type Role struct{ enum.WrapEnum[role] }
+
+	var (
+		RoleUser  = enum.NewExtended[Role](enum.Str("user"), enum.Alias("member"))
+		RoleAdmin = enum.NewExtended[Role](enum.Str("admin"), enum.Num(5))
+		RoleGuest = enum.NewExtended[Role](enum.Str("guest"))
+	)
+
+	assert.Equal(t, "user", RoleUser.String())
+	assert.Equal(t, 0, enum.MustTo[int](RoleUser))
+	assert.Equal(t, 5, enum.MustTo[int](RoleAdmin))
+	assert.Equal(t, 1, enum.MustTo[int](RoleGuest))
+
+	user, ok := enum.FromString[Role]("member")
+	assert.True(t, ok)
+	assert.Equal(t, RoleUser, user)
+
+	assert.Equal(t, []Role{RoleUser, RoleAdmin, RoleGuest}, enum.All[Role]())
+
+	assert.PanicsWithValue(t, "enum Role: cannot mix options with other representations (root)",
+		func() { enum.NewExtended[Role](enum.Str("admin"), "root") })
+}
+
+func TestMapOptsNoGuess(t *testing.T) {
+	type Role int
+
+	assert.PanicsWithValue(t, "enum Role (0): not found any string representation",
+		func() { enum.MapOpts(Role(0), enum.Repr(optionProtoRole(1))) })
+}
+
+func TestMapOptsInvalid(t *testing.T) {
+	type Role int
+	type Label string
+
+	enum.Map(Role(0), "user")
+
+	assert.PanicsWithValue(t, "enum Role (1): cannot mix options with other representations (admin)",
+		func() { enum.Map(Role(1), enum.Str("admin"), "admin") })
+	assert.PanicsWithValue(t, "enum Role: cannot mix options with other representations (admin)",
+		func() { enum.New[Role](enum.Num(1), "admin") })
+	assert.PanicsWithValue(t, "enum Role (1): multiple strings are provided (admin, root)",
+		func() { enum.MapOpts(Role(1), enum.Str("admin"), enum.Str("root")) })
+	assert.PanicsWithValue(t, "enum Role (1): multiple primitive numerics are provided (1, 2)",
+		func() { enum.MapOpts(Role(1), enum.Str("admin"), enum.Num(2)) })
+	assert.PanicsWithValue(t, "enum Role (1): custom representation admin cannot be a primitive string or number",
+		func() { enum.MapOpts(Role(1), enum.Str("admin"), enum.Repr("admin")) })
+	assert.PanicsWithValue(t, "enum Role (1): alias user was already mapped to 0",
+		func() { enum.MapOpts(Role(1), enum.Str("admin"), enum.Alias("user")) })
+	assert.PanicsWithValue(t, "enum Label (\"admin\"): multiple primitive strings are provided (admin, root)",
+		func() { enum.MapOpts(Label("admin"), enum.Str("root")) })
+
+	assert.Len(t, enum.All[Role](), 1)
+}
+
+func TestMapOptsAliasStringMatcher(t *testing.T) {
+	type Role int
+
+	RoleAdmin := enum.MapOpts(Role(0), enum.Str("admin"), enum.Alias("Administrator"))
+	enum.SetStringMatcher[Role](enum.MatchFoldNFC)
+
+	value, ok := enum.FromString[Role]("ADMINISTRATOR")
+	assert.True(t, ok)
+	assert.Equal(t, RoleAdmin, value)
+
+	assert.PanicsWithValue(t, "enum Role (1): alias ADMIN collides with a string of 0 under the string matcher",
+		func() { enum.MapOpts(Role(1), enum.Str("user"), enum.Alias("ADMIN")) })
+}
+
+type (
+	legacyOptionRole int
+	optionRole       int
+)
+
+func TestMapOptsSameAsLegacy(t *testing.T) {
+	enum.Map(legacyOptionRole(0), "user", optionProtoRole(1))
+	enum.Map(legacyOptionRole(3), "admin", optionProtoRole(2))
+	enum.New[legacyOptionRole]("guest")
+
+	enum.MapOpts(optionRole(0), enum.Str("user"), enum.Repr(optionProtoRole(1)))
+	enum.MapOpts(optionRole(3), enum.Str("admin"), enum.Repr(optionProtoRole(2)))
+	enum.NewOpts[optionRole](enum.Str("guest"))
+
+	data, err := enum.ExportRegistry()
+	assert.NoError(t, err)
+
+	var export struct {
+		Types []struct {
+			Name   string
+			Values json.RawMessage
+		}
+	}
+	assert.NoError(t, json.Unmarshal(data, &export))
+
+	values := make(map[string]string)
+	for _, typ := range export.Types {
+		values[typ.Name] = string(typ.Values)
+	}
+
+	assert.NotEmpty(t, values["legacyOptionRole"])
+	assert.Equal(t, values["legacyOptionRole"], values["optionRole"])
+
+	for _, legacy := range enum.All[legacyOptionRole]() {
+		opt := optionRole(legacy)
+		assert.Equal(t, enum.ToString(legacy), enum.ToString(opt))
+		assert.Equal(t, enum.MustTo[optionProtoRole](legacy), enum.MustTo[optionProtoRole](opt))
+		assert.Equal(t, enum.MustTo[float64](legacy), enum.MustTo[float64](opt))
+
+		if proto, ok := enum.To[optionProtoRole](legacy); ok {
+			assert.Equal(t, legacy, enum.MustFrom[legacyOptionRole](proto))
+			assert.Equal(t, opt, enum.MustFrom[optionRole](proto))
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestMapPair(t *testing.T) {
+	type Err int
+
+	var (
+		ErrQuotaExceeded = enum.MapPair(enum.New[Err]("quota_exceeded"), enum.Pair(4, 12))
+		ErrNotFound      = enum.MapPair(enum.New[Err]("not_found"), enum.Pair(4, 4))
+		ErrUnknown       = enum.New[Err]("unknown")
+	)
+
+	e, ok := enum.FromPair[Err](4, 12)
+	assert.True(t, ok)
+	assert.Equal(t, ErrQuotaExceeded, e)
+
+	e, ok = enum.FromPair[Err](4, 4)
+	assert.True(t, ok)
+	assert.Equal(t, ErrNotFound, e)
+
+	_, ok = enum.FromPair[Err](12, 4)
+	assert.False(t, ok)
+
+	major, minor, ok := enum.ToPair(ErrQuotaExceeded)
+	assert.True(t, ok)
+	assert.Equal(t, 4, major)
+	assert.Equal(t, 12, minor)
+
+	_, _, ok = enum.ToPair(ErrUnknown)
+	assert.False(t, ok)
+
+	assert.Equal(t, "4.12", enum.Pair(4, 12).String())
+}
+
+func TestMapPairPanics(t *testing.T) {
+	type Err int
+
+	var (
+		ErrQuotaExceeded = enum.MapPair(enum.New[Err]("quota_exceeded"), enum.Pair(4, 12))
+		ErrNotFound      = enum.New[Err]("not_found")
+	)
+
+	assert.PanicsWithValue(t, "enum Err (1): pair 4.12 was already mapped to 0", func() {
+		enum.MapPair(ErrNotFound, enum.Pair(4, 12))
+	})
+
+	assert.PanicsWithValue(t, "enum Err (0): do not map pair twice", func() {
+		enum.MapPair(ErrQuotaExceeded, enum.Pair(4, 13))
+	})
+
+	assert.PanicsWithValue(t, "enum Err (42): cannot map a pair to an invalid value", func() {
+		enum.MapPair(Err(42), enum.Pair(5, 1))
+	})
+
+	assert.PanicsWithValue(t, "enum Err (1): pair -1.2 must not have negative numbers", func() {
+		enum.MapPair(ErrNotFound, enum.Pair(-1, 2))
+	})
+
+	_, _, ok := enum.ToPair(ErrNotFound)
+	assert.False(t, ok)
+}
+
+func TestPairFormat(t *testing.T) {
+	type code any
+	type Err = enum.WrapEnum[code]
+
+	var (
+		ErrQuotaExceeded = enum.MapPair(enum.New[Err]("quota_exceeded"), enum.Pair(4, 12))
+		ErrUnknown       = enum.New[Err]("unknown")
+	)
+
+	type Response struct {
+		Error Err `json:"error"`
+	}
+
+	data, err := json.Marshal(Response{Error: ErrQuotaExceeded})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"error":"quota_exceeded"}`, string(data))
+
+	enum.UsePairFormat[Err](true)
+
+	data, err = json.Marshal(Response{Error: ErrQuotaExceeded})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"error":"4.12"}`, string(data))
+
+	_, err = json.Marshal(Response{Error: ErrUnknown})
+	assert.ErrorContains(t, err, "enum WrapEnum[code]: invalid value")
+
+	var resp Response
+	assert.NoError(t, json.Unmarshal([]byte(`{"error":"4.12"}`), &resp))
+	assert.Equal(t, ErrQuotaExceeded, resp.Error)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"error":"unknown"}`), &resp))
+	assert.Equal(t, ErrUnknown, resp.Error)
+
+	value, err := ErrQuotaExceeded.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "4.12", value)
+
+	var scanned Err
+	assert.NoError(t, scanned.Scan([]byte("4.12")))
+	assert.Equal(t, ErrQuotaExceeded, scanned)
+}
+
+func TestPairFormatInvalidInputs(t *testing.T) {
+	type Err int
+
+	enum.MapPair(enum.New[Err]("quota_exceeded"), enum.Pair(4, 12))
+	enum.UsePairFormat[Err](true)
+
+	var e Err
+	for input, msg := range map[string]string{
+		"4.13":    "enum Err: unknown pair 4.13",
+		"4.x":     "enum Err: invalid pair 4.x",
+		"4.":      "enum Err: invalid pair 4.",
+		".12":     "enum Err: invalid pair .12",
+		"4.12.1":  "enum Err: invalid pair 4.12.1",
+		"-4.12":   "enum Err: invalid pair -4.12",
+		"4.+12":   "enum Err: invalid pair 4.+12",
+		"timeout": "enum Err: unknown string timeout",
+	} {
+		assert.EqualError(t, enum.UnmarshalJSON([]byte(`"`+input+`"`), &e), msg, input)
+		assert.EqualError(t, enum.ScanSQL(input, &e), msg, input)
+	}
+}
@@ -0,0 +1,168 @@
+package testing_test
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+	"gopkg.in/yaml.v3"
+)
+
+type wrapperMethods interface {
+	IsValid() bool
+	MarshalJSON() ([]byte, error)
+	MarshalXML(*xml.Encoder, xml.StartElement) error
+	MarshalYAML() (any, error)
+	MarshalText() ([]byte, error)
+	Value() (driver.Value, error)
+	String() string
+	GoString() string
+	StringOr(string) string
+	IntOr(int) int
+}
+
+type wrapperPointerMethods[T any] interface {
+	*T
+	UnmarshalJSON([]byte) error
+	UnmarshalXML(*xml.Decoder, xml.StartElement) error
+	UnmarshalYAML(*yaml.Node) error
+	UnmarshalText([]byte) error
+	Scan(any) error
+}
+
+// assertInvalidNoPanic calls every method of an invalid wrapper enum, and
+// asserts that none of them panics. Int is excluded, it panics by design (see
+// TestIntMethods).
+func assertInvalidNoPanic[T any, P wrapperPointerMethods[T]](t *testing.T, value T) {
+	t.Helper()
+
+	w := any(value).(wrapperMethods)
+
+	assert.NotPanics(t, func() {
+		assert.False(t, w.IsValid())
+		assert.Equal(t, "<nil>", w.String())
+		assert.Equal(t, "default", w.StringOr("default"))
+		assert.Equal(t, -1, w.IntOr(-1))
+		assert.NotEmpty(t, w.GoString())
+
+		_, err := w.MarshalJSON()
+		assert.Error(t, err)
+
+		_, err = json.Marshal(value)
+		assert.Error(t, err)
+
+		assert.Error(t, w.MarshalXML(xml.NewEncoder(&bytes.Buffer{}), xml.StartElement{}))
+
+		_, err = w.MarshalYAML()
+		assert.Error(t, err)
+
+		_, err = w.MarshalText()
+		assert.Error(t, err)
+
+		_, err = w.Value()
+		assert.Error(t, err)
+
+		_ = fmt.Sprintf("%v %+v %#v", value, value, value)
+
+		if i, ok := any(value).(interface{ IntE() (int, error) }); ok {
+			_, err := i.IntE()
+			assert.Error(t, err)
+		}
+
+		if to, ok := reflect.ValueOf(value).Type().MethodByName("To"); ok {
+			to.Func.Call([]reflect.Value{reflect.ValueOf(value)})
+		}
+
+		copied := value
+		p := P(&copied)
+		assert.Error(t, p.UnmarshalJSON([]byte(`"bogus"`)))
+		assert.NoError(t, p.UnmarshalJSON([]byte(`null`)))
+		assert.Error(t, p.UnmarshalXML(xml.NewDecoder(bytes.NewBufferString("<a>bogus</a>")), xml.StartElement{}))
+		assert.Error(t, p.UnmarshalYAML(&yaml.Node{Kind: yaml.ScalarNode, Value: "bogus"}))
+		assert.Error(t, p.UnmarshalText([]byte("bogus")))
+		assert.Error(t, p.Scan("bogus"))
+		assert.Error(t, p.Scan(nil))
+	})
+}
+
+func TestWrapEnumInvalidNoPanic(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type unregistered any
+
+	var (
+		_ = enum.New[Role]("user")
+	)
+
+	assertInvalidNoPanic(t, Role(42))
+	assertInvalidNoPanic(t, Role(-1))
+	assertInvalidNoPanic(t, enum.WrapEnum[unregistered](0))
+}
+
+func TestWrapUintEnumInvalidNoPanic(t *testing.T) {
+	type role any
+	type Role = enum.WrapUintEnum[role]
+	type unregistered any
+
+	var (
+		_ = enum.New[Role]("user")
+	)
+
+	assertInvalidNoPanic(t, Role(42))
+	assertInvalidNoPanic(t, enum.WrapUintEnum[unregistered](0))
+}
+
+func TestWrapFloatEnumInvalidNoPanic(t *testing.T) {
+	type role any
+	type Role = enum.WrapFloatEnum[role]
+	type unregistered any
+
+	var (
+		_ = enum.New[Role]("user")
+	)
+
+	assertInvalidNoPanic(t, Role(0.5))
+	assertInvalidNoPanic(t, Role(42))
+	assertInvalidNoPanic(t, enum.WrapFloatEnum[unregistered](0))
+}
+
+func TestSafeEnumInvalidNoPanic(t *testing.T) {
+	type role any
+	type Role = enum.SafeEnum[role]
+
+	var (
+		_ = enum.New[Role]("user")
+	)
+
+	assertInvalidNoPanic(t, Role{})
+
+	// A SafeEnum built by reflection with an unregistered inner value.
+	var bogus Role
+	field := reflect.ValueOf(&bogus).Elem().Field(0)
+	reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem().SetString("bogus")
+	assertInvalidNoPanic(t, bogus)
+}
+
+func TestWrapperStringOrIntOr(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type SafeRole = enum.SafeEnum[role]
+
+	var (
+		RoleUser      = enum.New[Role]("user")
+		_             = enum.New[SafeRole]("user")
+		SafeRoleAdmin = enum.New[SafeRole]("admin")
+	)
+
+	assert.Equal(t, "user", RoleUser.StringOr("unknown"))
+	assert.Equal(t, 0, RoleUser.IntOr(-1))
+	assert.Equal(t, "admin", SafeRoleAdmin.StringOr("unknown"))
+	assert.Equal(t, 1, SafeRoleAdmin.IntOr(-1))
+}
@@ -0,0 +1,103 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseString(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser = enum.New[Role]("user")
+		_        = enum.New[Role]("admin")
+	)
+
+	role, err := enum.ParseString[Role]("user")
+	assert.NoError(t, err)
+	assert.Equal(t, RoleUser, role)
+
+	_, err = enum.ParseString[Role]("moderator")
+	assert.EqualError(t, err, "enum Role: unknown string moderator (allowed values: user, admin)")
+
+	var parseErr *enum.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "Role", parseErr.Type)
+	assert.Equal(t, "moderator", parseErr.Input)
+	assert.Equal(t, []string{"user", "admin"}, parseErr.Allowed)
+}
+
+func TestParseNumber(t *testing.T) {
+	type Role int
+
+	var (
+		_         = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	role, err := enum.ParseNumber[Role](1)
+	assert.NoError(t, err)
+	assert.Equal(t, RoleAdmin, role)
+
+	_, err = enum.ParseNumber[Role](42)
+	assert.EqualError(t, err, "enum Role: unknown number 42 (allowed values: user, admin)")
+
+	var parseErr *enum.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 42, parseErr.Input)
+}
+
+func TestParseErrorDeserialization(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		_ = enum.New[Role]("user")
+		_ = enum.New[Role]("admin")
+	)
+
+	var r Role
+	var parseErr *enum.ParseError
+
+	err := json.Unmarshal([]byte(`"moderator"`), &r)
+	assert.EqualError(t, err, "enum WrapEnum[role]: unknown string moderator")
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "moderator", parseErr.Input)
+	assert.Nil(t, parseErr.Allowed)
+
+	err = yaml.Unmarshal([]byte(`moderator`), &r)
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "moderator", parseErr.Input)
+
+	enum.SetErrorValueListing[Role](true)
+	err = r.Scan("moderator")
+	assert.EqualError(t, err, "enum WrapEnum[role]: unknown string moderator (allowed values: user, admin)")
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, []string{"user", "admin"}, parseErr.Allowed)
+
+	err = yaml.Unmarshal([]byte(`42`), &r)
+	assert.EqualError(t, err, "enum WrapEnum[role]: unknown number 42")
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, int64(42), parseErr.Input)
+}
+
+func TestParseErrorJSONNumber(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	_ = enum.New[Role]("user")
+	enum.SetJSONFormat[Role](enum.JSONNumber)
+
+	var r Role
+	err := json.Unmarshal([]byte(`42`), &r)
+	assert.EqualError(t, err, "enum WrapEnum[role]: unknown number 42")
+
+	var parseErr *enum.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, json.Number("42"), parseErr.Input)
+}
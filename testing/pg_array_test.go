@@ -0,0 +1,92 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestPGArrayValue(t *testing.T) {
+	type Label int
+	type Labels = enum.PGArray[Label]
+
+	var (
+		LabelUser   = enum.New[Label]("user")
+		LabelAdmin  = enum.New[Label]("admin")
+		LabelComma  = enum.New[Label]("a,b")
+		LabelQuote  = enum.New[Label](`say "hi"`)
+		LabelSlash  = enum.New[Label](`back\slash`)
+		LabelSpaced = enum.New[Label]("two words")
+		LabelNull   = enum.New[Label]("null")
+	)
+
+	v, err := Labels{LabelAdmin, LabelUser}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "{admin,user}", v)
+
+	v, err = Labels{LabelComma, LabelQuote, LabelSlash, LabelSpaced, LabelNull}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"a,b","say \"hi\"","back\\slash","two words","null"}`, v)
+
+	v, err = Labels{}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "{}", v)
+
+	v, err = Labels(nil).Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	_, err = Labels{LabelUser, Label(42)}.Value()
+	assert.ErrorContains(t, err, "index 1")
+}
+
+func TestPGArrayScan(t *testing.T) {
+	type Label int
+	type Labels = enum.PGArray[Label]
+
+	var (
+		LabelUser  = enum.New[Label]("user")
+		LabelAdmin = enum.New[Label]("admin")
+		LabelComma = enum.New[Label]("a,b")
+		LabelQuote = enum.New[Label](`say "hi"`)
+		LabelNull  = enum.New[Label]("null")
+	)
+
+	var got Labels
+	assert.NoError(t, got.Scan([]byte("{admin,user}")))
+	assert.Equal(t, Labels{LabelAdmin, LabelUser}, got)
+
+	assert.NoError(t, got.Scan(`{ "a,b" , "say \"hi\"", user ,"null"}`))
+	assert.Equal(t, Labels{LabelComma, LabelQuote, LabelUser, LabelNull}, got)
+
+	assert.NoError(t, got.Scan("{}"))
+	assert.Equal(t, Labels{}, got)
+
+	assert.NoError(t, got.Scan(nil))
+	assert.Nil(t, got)
+
+	for _, literal := range []Labels{{LabelComma, LabelQuote, LabelNull}, {}} {
+		v, err := literal.Value()
+		assert.NoError(t, err)
+		assert.NoError(t, got.Scan(v))
+		assert.Equal(t, literal, got)
+	}
+}
+
+func TestPGArrayScanInvalid(t *testing.T) {
+	type Label int
+	type Labels = enum.PGArray[Label]
+
+	enum.New[Label]("user")
+
+	var got Labels
+	assert.ErrorContains(t, got.Scan("{user,NULL}"), "NULL element at index 1")
+	assert.EqualError(t, got.Scan("{user,guest}"), "enum Label: unknown string guest at index 1")
+	assert.ErrorIs(t, got.Scan("{user,guest}"), enum.ErrUnknownString)
+	assert.ErrorContains(t, got.Scan(`{"user}`), "unterminated")
+	assert.ErrorContains(t, got.Scan("{{user}}"), "unexpected {")
+	assert.ErrorContains(t, got.Scan("user"), "invalid array literal")
+	assert.EqualError(t, got.Scan("[1:2]={user,user}"), "enum Label: invalid array literal [1:2]={user,user}")
+	assert.ErrorContains(t, got.Scan(42), "does not support type int")
+}
@@ -0,0 +1,127 @@
+package testing_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+// pgText simulates a typed wrapper returned by a driver for an enum column,
+// like pgtype.Text of pgx.
+type pgText struct {
+	String string
+	Valid  bool
+}
+
+func (t pgText) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+
+	return t.String, nil
+}
+
+type failingValuer struct{}
+
+func (failingValuer) Value() (driver.Value, error) {
+	return nil, errors.New("connection lost")
+}
+
+func TestPostgresType(t *testing.T) {
+	type userRole any
+	type UserRole = enum.WrapEnum[userRole]
+	type HTTPStatus int
+
+	var (
+		_ = enum.New[UserRole]("user")
+		_ = enum.New[UserRole]("admin")
+		_ = enum.New[UserRole]("o'brien")
+
+		_ = enum.New[HTTPStatus]("ok")
+	)
+
+	pgType := enum.PostgresType[UserRole]()
+	assert.Equal(t, enum.PostgresEnumType{Name: "user_role", Labels: []string{"user", "admin", "o'brien"}}, pgType)
+	assert.Equal(t, "CREATE TYPE user_role AS ENUM ('user', 'admin', 'o''brien')", pgType.CreateTypeSQL())
+
+	assert.Equal(t, "http_status", enum.PostgresType[HTTPStatus]().Name)
+}
+
+func TestScanSQLPostgresLabel(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		_         = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	// Binary format: the label is given as []byte.
+	var r Role
+	assert.NoError(t, r.Scan([]byte("admin")))
+	assert.Equal(t, RoleAdmin, r)
+
+	// Typed wrapper.
+	r = Role(42)
+	assert.NoError(t, r.Scan(pgText{String: "admin", Valid: true}))
+	assert.Equal(t, RoleAdmin, r)
+
+	assert.EqualError(t, r.Scan(pgText{String: "moderator", Valid: true}), "enum WrapEnum[role]: unknown string moderator")
+	assert.EqualError(t, r.Scan(pgText{}), "enum WrapEnum[role]: not support type <nil>")
+	assert.EqualError(t, r.Scan(failingValuer{}), "enum WrapEnum[role]: connection lost")
+
+	var nr enum.Nullable[Role]
+	assert.NoError(t, nr.Scan(pgText{String: "admin", Valid: true}))
+	assert.Equal(t, enum.Nullable[Role]{Enum: RoleAdmin, Valid: true}, nr)
+}
+
+func Example_postgresEnum() {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	// Declare the enum type in PostgreSQL, or register it in pgx by its name.
+	pgType := enum.PostgresType[Role]()
+	fmt.Println(pgType.CreateTypeSQL())
+
+	// The in-memory database has no enum type, so a TEXT column stands for
+	// the "role" column.
+	db, _ := sql.Open("sqlite3", ":memory:")
+	defer db.Close()
+
+	db.Exec(`CREATE TABLE users (id INTEGER PRIMARY KEY, role TEXT)`)
+	db.Exec(`INSERT INTO users (role) VALUES (?), (?), ('moderator')`, RoleUser, RoleAdmin)
+
+	rows, _ := db.Query(`SELECT role FROM users ORDER BY id`)
+	defer rows.Close()
+
+	for rows.Next() {
+		// A driver may surface the label via a typed wrapper.
+		var label pgText
+		rows.Scan(&label.String)
+		label.Valid = true
+
+		var r Role
+		if err := r.Scan(label); err != nil {
+			fmt.Println(err)
+			continue
+		}
+
+		fmt.Println(r)
+	}
+
+	// Output:
+	// CREATE TYPE role AS ENUM ('user', 'admin')
+	// user
+	// admin
+	// enum WrapEnum[role]: unknown string moderator
+}
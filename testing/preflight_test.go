@@ -0,0 +1,113 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestPreflightMarshalNested(t *testing.T) {
+	type Status int
+
+	var (
+		StatusActive   = enum.New[Status]("active")
+		StatusInactive = enum.New[Status]("inactive")
+	)
+
+	type Item struct {
+		Status Status `json:"status"`
+	}
+
+	type Group struct {
+		Items []Item `json:"items"`
+	}
+
+	type Document struct {
+		Payload map[string]Group `json:"payload"`
+	}
+
+	doc := Document{Payload: map[string]Group{
+		"a": {Items: []Item{{StatusActive}, {StatusInactive}}},
+		"b": {Items: []Item{{StatusActive}, {Status(9)}}},
+	}}
+
+	err := enum.PreflightMarshal(doc)
+	assert.EqualError(t, err, "payload[b].items[1].status: invalid value 9 for Status")
+
+	_, err = enum.MarshalJSONStrict(doc)
+	assert.EqualError(t, err, "payload[b].items[1].status: invalid value 9 for Status")
+
+	doc.Payload["b"].Items[1].Status = StatusInactive
+	assert.NoError(t, enum.PreflightMarshal(doc))
+
+	data, err := enum.MarshalJSONStrict(doc)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"payload":{"a":{"items":[{"status":0},{"status":1}]},"b":{"items":[{"status":0},{"status":1}]}}}`,
+		string(data))
+}
+
+func TestPreflightMarshalAggregated(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type Status int
+
+	var (
+		RoleUser     = enum.New[Role]("user")
+		StatusActive = enum.New[Status]("active")
+	)
+
+	type Base struct {
+		Status Status
+	}
+
+	type Node struct {
+		Base
+		Name     string              `json:"name"`
+		Role     *Role               `json:"role"`
+		Extra    any                 `json:"extra"`
+		NullRole enum.Nullable[Role] `json:"null_role"`
+		Ignored  Status              `json:"-"`
+		Children []*Node             `json:"children,omitempty"`
+		parent   *Node
+	}
+
+	invalidRole := Role(42)
+	root := &Node{
+		Base:     Base{Status: StatusActive},
+		Role:     &RoleUser,
+		Extra:    Status(7),
+		NullRole: enum.Nullable[Role]{Enum: Role(43)},
+		Ignored:  Status(8),
+	}
+	root.Children = []*Node{
+		{Base: Base{Status: Status(5)}, Role: &invalidRole, parent: root},
+		{Base: Base{Status: StatusActive}, NullRole: enum.Nullable[Role]{Enum: Role(44), Valid: true}, parent: root},
+		root, // cycles are walked once.
+	}
+
+	err := enum.PreflightMarshal(root)
+	assert.EqualError(t, err, ""+
+		"extra: invalid value 7 for Status\n"+
+		"children[0].Status: invalid value 5 for Status\n"+
+		"children[0].role: invalid value 42 for Role\n"+
+		"children[1].null_role: invalid value 44 for Role")
+
+	_, err = enum.MarshalJSONStrict(root)
+	assert.Error(t, err)
+}
+
+func TestPreflightMarshalRoot(t *testing.T) {
+	type Status string
+
+	var (
+		StatusActive = enum.New[Status]("active")
+	)
+
+	assert.NoError(t, enum.PreflightMarshal(StatusActive))
+	assert.EqualError(t, enum.PreflightMarshal(Status("unknown")), `invalid value "unknown" for Status`)
+	assert.EqualError(t, enum.PreflightMarshal([]Status{StatusActive, "unknown"}), `[1]: invalid value "unknown" for Status`)
+	assert.NoError(t, enum.PreflightMarshal(nil))
+	assert.NoError(t, enum.PreflightMarshal("not an enum"))
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/xybor-x/enum"
+	"github.com/xybor-x/enum/protoenum"
+	"github.com/xybor-x/enum/testing/crosspkg"
 	"github.com/xybor-x/enum/testing/proto"
 )
 
@@ -210,3 +212,62 @@ func TestProtoMapMustImpl(t *testing.T) {
 		func() { enum.Map(RoleAdmin, "admin") },
 	)
 }
+
+func TestProtoNewAll(t *testing.T) {
+	type Role int
+
+	roles := protoenum.NewAll[Role, proto.ProtoRole]()
+	assert.Equal(t, []Role{0, 1, 2}, roles)
+	assert.Equal(t, roles, enum.All[Role]())
+
+	r, ok := enum.From[Role](proto.ProtoRole_Admin)
+	assert.True(t, ok)
+	assert.Equal(t, Role(1), r)
+
+	r, ok = enum.From[Role]("SomethingElse")
+	assert.True(t, ok)
+	assert.Equal(t, Role(2), r)
+
+	assert.Equal(t, "Admin", enum.ToString(Role(1)))
+	assert.Equal(t, 2, enum.MustTo[int](Role(2)))
+	assert.Equal(t, proto.ProtoRole_User, enum.MustTo[proto.ProtoRole](Role(0)))
+}
+
+func TestProtoNewAllSkip(t *testing.T) {
+	type Role int
+
+	roles := protoenum.NewAll[Role, proto.ProtoRole](protoenum.Skip("SomethingElse"))
+	assert.Equal(t, []Role{0, 1}, roles)
+
+	_, ok := enum.From[Role](proto.ProtoRole_SomethingElse)
+	assert.False(t, ok)
+
+	type Other int
+	assert.PanicsWithValue(t, "enum Other: xyborenum.testing.ProtoRole has no value Guest", func() {
+		protoenum.NewAll[Other, proto.ProtoRole](protoenum.Skip("Guest"))
+	})
+}
+
+func TestProtoNewAllCollision(t *testing.T) {
+	type Role int
+
+	enum.New[Role]("Admin")
+
+	assert.Panics(t, func() {
+		protoenum.NewAll[Role, proto.ProtoRole]()
+	})
+}
+
+func TestProtoNewAllRegisteredFrom(t *testing.T) {
+	type Role int
+
+	protoenum.NewAll[Role, proto.ProtoRole]()
+
+	// The package calling NewAll owns the values, not protoenum.
+	assert.Equal(t, []string{"github.com/xybor-x/enum/testing_test"}, enum.RegisteredFromPackages[Role]())
+
+	assert.PanicsWithValue(t,
+		"enum Role (3): string Admin was already mapped to 1 "+
+			"(registered from packages github.com/xybor-x/enum/testing_test, github.com/xybor-x/enum/testing/crosspkg)",
+		func() { crosspkg.New[Role]("Admin") })
+}
@@ -0,0 +1,106 @@
+package testing_test
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestQuickGenerator(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type status any
+	type Status = enum.SafeEnum[status]
+
+	var (
+		_ = enum.New[Role]("user")
+		_ = enum.New[Role]("admin")
+		_ = enum.New[Role]("guest")
+		_ = enum.New[Status]("active")
+		_ = enum.New[Status]("closed")
+	)
+
+	roundTrip := func(r Role, s Status) bool {
+		r2, ok := enum.FromString[Role](enum.ToString(r))
+		if !ok || r2 != r {
+			return false
+		}
+
+		s2, ok := enum.FromString[Status](enum.ToString(s))
+		return ok && s2 == s
+	}
+
+	assert.NoError(t, quick.Check(roundTrip, nil))
+}
+
+func TestGenerate(t *testing.T) {
+	type Role int
+
+	var (
+		_ = enum.New[Role]("user")
+		_ = enum.New[Role]("admin")
+	)
+
+	r := rand.New(rand.NewSource(1))
+	seen := map[Role]int{}
+	for i := 0; i < 1000; i++ {
+		value := enum.Generate[Role](r)
+		assert.True(t, enum.IsValid(value))
+		seen[value]++
+	}
+
+	assert.Len(t, seen, 2)
+	assert.InDelta(t, 500, seen[0], 100)
+
+	type Empty int
+	assert.PanicsWithValue(t, "enum Empty: no registered value to generate", func() {
+		enum.Generate[Empty](r)
+	})
+}
+
+func TestRandom(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type status any
+	type Status = enum.SafeEnum[status]
+	type Level int
+
+	var (
+		_ = enum.New[Role]("user")
+		_ = enum.New[Role]("admin")
+		_ = enum.New[Status]("active")
+		_ = enum.New[Level]("low")
+		_ = enum.New[Level]("high")
+		_ = enum.New[Level]("critical")
+	)
+
+	assert.True(t, enum.IsValid(enum.Random[Role]()))
+	assert.True(t, enum.IsValid(enum.Random[Status]()))
+	assert.True(t, enum.IsValid(enum.Random[Level]()))
+
+	seen := map[Level]int{}
+	for _, level := range enum.RandomN[Level](3000) {
+		seen[level]++
+	}
+	for _, level := range enum.All[Level]() {
+		assert.InDelta(t, 1000, seen[level], 200)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	levels := enum.GenerateN[Level](r, 10)
+	assert.Len(t, levels, 10)
+	assert.Equal(t, levels, enum.GenerateN[Level](rand.New(rand.NewSource(1)), 10))
+
+	assert.Empty(t, enum.RandomN[Level](0))
+
+	type Empty int
+	assert.PanicsWithValue(t, "enum Empty: no registered value to generate", func() {
+		enum.Random[Empty]()
+	})
+	assert.PanicsWithValue(t, "enum Empty: no registered value to generate", func() {
+		enum.RandomN[Empty](2)
+	})
+}
@@ -0,0 +1,145 @@
+package testing_test
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum/registry"
+)
+
+type permissionKey struct {
+	role     string
+	resource string
+}
+
+func (permissionKey) InferValue() bool { panic("not implemented") }
+
+type handlerKey struct{ name string }
+
+func (handlerKey) InferValue() any { panic("not implemented") }
+
+type counterKey struct{}
+
+func (counterKey) InferValue() *int { panic("not implemented") }
+
+func TestRegistryMap(t *testing.T) {
+	var m registry.Map
+
+	v, ok := registry.Get2M(&m, permissionKey{"admin", "user"})
+	assert.False(t, ok)
+	assert.False(t, v)
+	assert.False(t, registry.GetM(&m, permissionKey{"admin", "user"}))
+
+	registry.SetM(&m, permissionKey{"admin", "user"}, true)
+	registry.SetM(&m, permissionKey{"guest", "user"}, false)
+
+	v, ok = registry.Get2M(&m, permissionKey{"admin", "user"})
+	assert.True(t, ok)
+	assert.True(t, v)
+
+	v, ok = registry.Get2M(&m, permissionKey{"guest", "user"})
+	assert.True(t, ok)
+	assert.False(t, v)
+
+	registry.DeleteM(&m, permissionKey{"admin", "user"})
+	_, ok = registry.Get2M(&m, permissionKey{"admin", "user"})
+	assert.False(t, ok)
+
+	// Deleting a missing key does nothing.
+	registry.DeleteM(&m, permissionKey{"admin", "user"})
+	registry.DeleteM(&registry.Map{}, permissionKey{"admin", "user"})
+}
+
+func TestRegistryMapIsolation(t *testing.T) {
+	var m1, m2 registry.Map
+
+	registry.SetM(&m1, permissionKey{"admin", "user"}, true)
+
+	_, ok := registry.Get2M(&m2, permissionKey{"admin", "user"})
+	assert.False(t, ok)
+
+	_, ok = registry.Get2(permissionKey{"admin", "user"})
+	assert.False(t, ok)
+}
+
+func TestRegistryNilInterfaceValue(t *testing.T) {
+	var m registry.Map
+
+	registry.SetM(&m, handlerKey{"nil"}, nil)
+	registry.SetM(&m, handlerKey{"int"}, 1)
+
+	v, ok := registry.Get2M(&m, handlerKey{"nil"})
+	assert.True(t, ok)
+	assert.Nil(t, v)
+
+	v, ok = registry.Get2M(&m, handlerKey{"int"})
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	v, ok = registry.Get2M(&m, handlerKey{"missing"})
+	assert.False(t, ok)
+	assert.Nil(t, v)
+}
+
+func TestRegistryNilPointerValue(t *testing.T) {
+	var m registry.Map
+
+	registry.SetM(&m, counterKey{}, nil)
+
+	v, ok := registry.Get2M(&m, counterKey{})
+	assert.True(t, ok)
+	assert.Nil(t, v)
+}
+
+func TestRegistryGlobal(t *testing.T) {
+	type key struct{ permissionKey }
+
+	k := permissionKey{"global", "registry"}
+	defer registry.Delete(k)
+
+	_, ok := registry.Get2(k)
+	assert.False(t, ok)
+
+	registry.Set(k, true)
+	assert.True(t, registry.Get(k))
+
+	v, ok := registry.Get2(k)
+	assert.True(t, ok)
+	assert.True(t, v)
+
+	registry.Delete(k)
+	assert.False(t, registry.Get(k))
+
+	// Keys of different types never collide, even with the same content.
+	registry.Set(key{k}, false)
+	defer registry.Delete(key{k})
+	_, ok = registry.Get2(k)
+	assert.False(t, ok)
+}
+
+func TestRegistryConcurrentReadWrite(t *testing.T) {
+	var m registry.Map
+
+	registry.SetM(&m, permissionKey{"admin", "user"}, true)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				assert.True(t, registry.GetM(&m, permissionKey{"admin", "user"}))
+			}
+		}()
+	}
+
+	for i := 0; i < 1000; i++ {
+		registry.SetM(&m, permissionKey{"admin", strconv.Itoa(i)}, i%2 == 0)
+	}
+
+	wg.Wait()
+	assert.True(t, registry.GetM(&m, permissionKey{"admin", "998"}))
+	assert.False(t, registry.GetM(&m, permissionKey{"admin", "999"}))
+}
@@ -0,0 +1,60 @@
+package testing_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestFmtScanner(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin", 5)
+	)
+
+	var scanned Role
+	var n int
+	count, err := fmt.Sscanf("user 42", "%v %d", enum.FmtScanner(&scanned), &n)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, RoleUser, scanned)
+	assert.Equal(t, 42, n)
+
+	_, err = fmt.Sscanf("5", "%d", enum.FmtScanner(&scanned))
+	assert.NoError(t, err)
+	assert.Equal(t, RoleAdmin, scanned)
+
+	_, err = fmt.Sscan("  admin\n", enum.FmtScanner(&scanned))
+	assert.NoError(t, err)
+	assert.Equal(t, RoleAdmin, scanned)
+
+	_, err = fmt.Sscan("guest", enum.FmtScanner(&scanned))
+	assert.EqualError(t, err, "enum WrapEnum[role]: unknown string guest")
+
+	_, err = fmt.Sscanf("9", "%d", enum.FmtScanner(&scanned))
+	assert.EqualError(t, err, "enum WrapEnum[role]: unknown number 9")
+
+	_, err = fmt.Sscanf("user", "%x", enum.FmtScanner(&scanned))
+	assert.EqualError(t, err, "enum WrapEnum[role]: not support verb %x")
+}
+
+func TestFmtScannerSafeEnum(t *testing.T) {
+	type role any
+	type Role = enum.SafeEnum[role]
+
+	var (
+		RoleUser = enum.New[Role]("user")
+	)
+
+	var scanned Role
+	var n int
+	_, err := fmt.Sscan("user 42", enum.FmtScanner(&scanned), &n)
+	assert.NoError(t, err)
+	assert.Equal(t, RoleUser, scanned)
+	assert.Equal(t, 42, n)
+}
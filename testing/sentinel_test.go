@@ -0,0 +1,63 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	_ = enum.New[Role]("user")
+
+	var r Role
+
+	err := json.Unmarshal([]byte(`"moderator"`), &r)
+	assert.ErrorIs(t, err, enum.ErrUnknownString)
+	assert.NotErrorIs(t, err, enum.ErrUnknownNumber)
+	assert.EqualError(t, err, "enum WrapEnum[role]: unknown string moderator")
+
+	err = yaml.Unmarshal([]byte(`moderator`), &r)
+	assert.ErrorIs(t, err, enum.ErrUnknownString)
+
+	err = yaml.Unmarshal([]byte(`42`), &r)
+	assert.ErrorIs(t, err, enum.ErrUnknownNumber)
+	assert.NotErrorIs(t, err, enum.ErrUnknownString)
+	assert.EqualError(t, err, "enum WrapEnum[role]: unknown number 42")
+
+	err = xml.Unmarshal([]byte(`<Role>moderator</Role>`), &r)
+	assert.ErrorIs(t, err, enum.ErrUnknownString)
+
+	err = r.Scan("moderator")
+	assert.ErrorIs(t, err, enum.ErrUnknownString)
+
+	err = r.Scan(true)
+	assert.ErrorIs(t, err, enum.ErrUnsupportedScanType)
+	assert.EqualError(t, err, "enum WrapEnum[role]: not support type bool")
+
+	_, err = Role(42).Value()
+	assert.ErrorIs(t, err, enum.ErrInvalidEnum)
+	assert.EqualError(t, err, "enum WrapEnum[role]: invalid value 42")
+
+	_, err = json.Marshal(Role(42))
+	assert.ErrorIs(t, err, enum.ErrInvalidEnum)
+
+	_, err = enum.ParseNumber[Role](42)
+	assert.True(t, errors.Is(err, enum.ErrUnknownNumber))
+
+	var nr enum.Nullable[Role]
+	err = nr.Scan(int64(42))
+	assert.ErrorIs(t, err, enum.ErrUnknownNumber)
+	assert.EqualError(t, err, "enum WrapEnum[role]: unknown number 42")
+
+	var parseErr *enum.ParseError
+	assert.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, int64(42), parseErr.Input)
+}
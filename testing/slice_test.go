@@ -0,0 +1,272 @@
+package testing_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func openSliceTable(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`CREATE TABLE accounts (
+		id INTEGER PRIMARY KEY,
+		roles TEXT
+	);`)
+	assert.NoError(t, err)
+
+	return db
+}
+
+func TestSliceSerdeJSON(t *testing.T) {
+	type Role int
+	type Roles = enum.SliceSerde[Role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	var roles Roles
+	roles.Append(RoleAdmin, RoleUser)
+	roles.Append(RoleAdmin)
+	assert.Equal(t, []Role{RoleAdmin, RoleUser, RoleAdmin}, roles.Enums())
+
+	data, err := json.Marshal(roles)
+	assert.NoError(t, err)
+	assert.Equal(t, `["admin","user","admin"]`, string(data))
+
+	var got Roles
+	assert.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, roles, got)
+
+	data, err = json.Marshal(Roles(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &got))
+	assert.Nil(t, got)
+}
+
+func TestSliceSerdeInvalid(t *testing.T) {
+	type Role int
+	type Roles = enum.SliceSerde[Role]
+
+	RoleUser := enum.New[Role]("user")
+
+	var roles Roles
+	err := json.Unmarshal([]byte(`["user","moderator"]`), &roles)
+	assert.EqualError(t, err, "index 1: enum Role: unknown string moderator")
+	assert.ErrorIs(t, err, enum.ErrUnknownString)
+
+	err = json.Unmarshal([]byte(`{"user":1}`), &roles)
+	assert.EqualError(t, err, `enum Role: SliceSerde requires a JSON array, got {"user":1}`)
+
+	_, err = json.Marshal(Roles{RoleUser, Role(42)})
+	assert.ErrorContains(t, err, "index 1: enum Role: invalid value 42")
+	assert.ErrorIs(t, err, enum.ErrInvalidEnum)
+}
+
+func TestSliceSerdeSQL(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type Roles = enum.SliceSerde[Role]
+	type NullRoles = enum.Nullable[Roles]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	db := openSliceTable(t)
+	defer db.Close()
+
+	_, err := db.Exec(`INSERT INTO accounts (roles) VALUES (?), (?), (?)`,
+		Roles{RoleAdmin, RoleUser}, Roles(nil), NullRoles{})
+	assert.NoError(t, err)
+
+	var raw string
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 1`).Scan(&raw))
+	assert.Equal(t, `["admin","user"]`, raw)
+
+	var roles Roles
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 1`).Scan(&roles))
+	assert.Equal(t, Roles{RoleAdmin, RoleUser}, roles)
+
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 2`).Scan(&roles))
+	assert.Equal(t, Roles{}, roles)
+
+	var nullRoles NullRoles
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 3`).Scan(&nullRoles))
+	assert.False(t, nullRoles.Valid)
+
+	assert.ErrorContains(t, roles.Scan(42), "enum WrapEnum[role]: SliceSerde does not support type int")
+}
+
+func TestCSVSliceSQL(t *testing.T) {
+	type Role int
+	type Roles = enum.CSVSlice[Role]
+	type NullRoles = enum.Nullable[Roles]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	db := openSliceTable(t)
+	defer db.Close()
+
+	var roles Roles
+	roles.Append(RoleAdmin, RoleUser)
+
+	_, err := db.Exec(`INSERT INTO accounts (roles) VALUES (?), (?), (?), (?)`,
+		roles, Roles(nil), NullRoles{}, " admin , user,")
+	assert.NoError(t, err)
+
+	var raw string
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 1`).Scan(&raw))
+	assert.Equal(t, "admin,user", raw)
+
+	var got Roles
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 1`).Scan(&got))
+	assert.Equal(t, Roles{RoleAdmin, RoleUser}, got)
+	assert.Equal(t, []Role{RoleAdmin, RoleUser}, got.Enums())
+
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 2`).Scan(&raw))
+	assert.Equal(t, "", raw)
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 2`).Scan(&got))
+	assert.NotNil(t, got)
+	assert.Empty(t, got)
+
+	var nullRoles NullRoles
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 3`).Scan(&nullRoles))
+	assert.False(t, nullRoles.Valid)
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 1`).Scan(&nullRoles))
+	assert.Equal(t, NullRoles{Enum: Roles{RoleAdmin, RoleUser}, Valid: true}, nullRoles)
+
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 4`).Scan(&got))
+	assert.Equal(t, Roles{RoleAdmin, RoleUser}, got)
+}
+
+func TestCSVSliceSQLMalformed(t *testing.T) {
+	type Role int
+	type Roles = enum.CSVSlice[Role]
+
+	_ = enum.New[Role]("user")
+
+	db := openSliceTable(t)
+	defer db.Close()
+
+	_, err := db.Exec(`INSERT INTO accounts (roles) VALUES (?)`, "user;admin,moderator")
+	assert.NoError(t, err)
+
+	var got Roles
+	err = db.QueryRow(`SELECT roles FROM accounts WHERE id = 1`).Scan(&got)
+	assert.ErrorContains(t, err, "token 0: enum Role: unknown string user;admin (allowed values: user)")
+	assert.ErrorContains(t, err, "token 1: enum Role: unknown string moderator (allowed values: user)")
+
+	_, err = Roles{Role(42)}.Value()
+	assert.EqualError(t, err, "index 0: enum Role: invalid value 42")
+	assert.ErrorContains(t, got.Scan(42), "enum Role: CSVSlice does not support type int")
+}
+
+func TestSliceSerdeNullable(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type Roles = enum.SliceSerde[Role]
+	type NullRoles = enum.Nullable[Roles]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	data, err := json.Marshal([]NullRoles{enum.Some(Roles{RoleAdmin, RoleUser}), {}})
+	assert.NoError(t, err)
+	assert.Equal(t, `[["admin","user"],null]`, string(data))
+
+	var roles NullRoles
+	assert.NoError(t, json.Unmarshal([]byte(`["admin","user"]`), &roles))
+	assert.Equal(t, enum.Some(Roles{RoleAdmin, RoleUser}), roles)
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &roles))
+	assert.False(t, roles.Valid)
+
+	db := openSliceTable(t)
+	defer db.Close()
+
+	_, err = db.Exec(`INSERT INTO accounts (roles) VALUES (?), (?)`, enum.Some(Roles{RoleAdmin, RoleUser}), NullRoles{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 1`).Scan(&roles))
+	assert.Equal(t, enum.Some(Roles{RoleAdmin, RoleUser}), roles)
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 2`).Scan(&roles))
+	assert.False(t, roles.Valid)
+}
+
+func TestCSVSliceNullable(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type Roles = enum.CSVSlice[Role]
+	type NullRoles = enum.Nullable[Roles]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	// In JSON, the slice is an array, as SliceSerde.
+	data, err := json.Marshal([]NullRoles{enum.Some(Roles{RoleAdmin, RoleUser}), {}})
+	assert.NoError(t, err)
+	assert.Equal(t, `[["admin","user"],null]`, string(data))
+
+	var roles NullRoles
+	assert.NoError(t, json.Unmarshal([]byte(`["admin","user"]`), &roles))
+	assert.Equal(t, enum.Some(Roles{RoleAdmin, RoleUser}), roles)
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &roles))
+	assert.False(t, roles.Valid)
+
+	assert.EqualError(t, json.Unmarshal([]byte(`"admin,user"`), &roles),
+		`enum WrapEnum[role]: CSVSlice requires a JSON array, got "admin,user"`)
+	assert.ErrorContains(t, json.Unmarshal([]byte(`["admin","guest"]`), &roles), "index 1")
+
+	db := openSliceTable(t)
+	defer db.Close()
+
+	_, err = db.Exec(`INSERT INTO accounts (roles) VALUES (?), (?)`, enum.Some(Roles{RoleAdmin, RoleUser}), NullRoles{})
+	assert.NoError(t, err)
+
+	var raw string
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 1`).Scan(&raw))
+	assert.Equal(t, "admin,user", raw)
+
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 1`).Scan(&roles))
+	assert.Equal(t, enum.Some(Roles{RoleAdmin, RoleUser}), roles)
+	assert.NoError(t, db.QueryRow(`SELECT roles FROM accounts WHERE id = 2`).Scan(&roles))
+	assert.False(t, roles.Valid)
+}
+
+func TestCSVSliceValueUnreadable(t *testing.T) {
+	type Tag int
+	type Tags = enum.CSVSlice[Tag]
+
+	var (
+		TagGo    = enum.New[Tag]("go")
+		TagComma = enum.New[Tag]("a,b")
+		TagSpace = enum.New[Tag](" padded")
+	)
+
+	// Strings which Scan would split or trim are rejected.
+	_, err := Tags{TagGo, TagComma}.Value()
+	assert.EqualError(t, err, `index 1: enum Tag: string "a,b" cannot be stored in a comma-separated list`)
+
+	_, err = Tags{TagSpace}.Value()
+	assert.EqualError(t, err, `index 0: enum Tag: string " padded" cannot be stored in a comma-separated list`)
+
+	v, err := Tags{TagGo}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "go", v)
+}
@@ -0,0 +1,49 @@
+package testing_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestSlogWrappers(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type Kind = enum.WrapUintEnum[role]
+	type Score = enum.WrapFloatEnum[role]
+	type Status = enum.SafeEnum[role]
+
+	var (
+		RoleAdmin   = enum.New[Role]("admin")
+		KindPublic  = enum.New[Kind]("public")
+		ScoreHigh   = enum.New[Score]("high", 0.5)
+		StatusReady = enum.New[Status]("ready")
+	)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	logger.Info("valid", "role", RoleAdmin, "kind", KindPublic, "score", ScoreHigh, "status", StatusReady)
+	assert.Contains(t, buf.String(), " msg=valid role=admin kind=public score=high status=ready\n")
+
+	buf.Reset()
+	logger.Info("invalid", "role", Role(42), "kind", Kind(7), "score", Score(2.5), "status", Status{})
+	assert.Contains(t, buf.String(), " msg=invalid role=42 kind=7 score=2.5 status=<nil>\n")
+}
+
+func TestSlogValue(t *testing.T) {
+	type Role int
+
+	var (
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	logger.Info("login", "role", enum.SlogValue(RoleAdmin), "other", enum.SlogValue(Role(42)))
+	assert.Contains(t, buf.String(), " msg=login role=admin other=42\n")
+}
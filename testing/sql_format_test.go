@@ -0,0 +1,177 @@
+package testing_test
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestSQLFormatNumber(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin", 5)
+	)
+
+	enum.SetSQLFormat[Role](enum.SQLNumber)
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE accounts (
+		id INTEGER PRIMARY KEY,
+		role SMALLINT,
+		backup_role SMALLINT
+	);`)
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`INSERT INTO accounts (role, backup_role) VALUES (?, ?), (?, ?)`,
+		RoleAdmin, NullRole{Enum: RoleUser, Valid: true},
+		RoleUser, NullRole{})
+	assert.NoError(t, err)
+
+	var kind string
+	var stored int64
+	assert.NoError(t, db.QueryRow(`SELECT typeof(role), role FROM accounts WHERE id = 1`).Scan(&kind, &stored))
+	assert.Equal(t, "integer", kind)
+	assert.Equal(t, int64(5), stored)
+
+	var scanned Role
+	var backup NullRole
+	assert.NoError(t, db.QueryRow(`SELECT role, backup_role FROM accounts WHERE id = 1`).Scan(&scanned, &backup))
+	assert.Equal(t, RoleAdmin, scanned)
+	assert.Equal(t, NullRole{Enum: RoleUser, Valid: true}, backup)
+
+	assert.NoError(t, db.QueryRow(`SELECT role, backup_role FROM accounts WHERE id = 2`).Scan(&scanned, &backup))
+	assert.Equal(t, RoleUser, scanned)
+	assert.False(t, backup.Valid)
+
+	// Strings are still accepted.
+	assert.NoError(t, scanned.Scan("admin"))
+	assert.Equal(t, RoleAdmin, scanned)
+
+	assert.EqualError(t, scanned.Scan(int64(9)), "enum WrapEnum[role]: unknown number 9")
+
+	_, err = Role(9).Value()
+	assert.EqualError(t, err, "enum WrapEnum[role]: invalid value 9")
+}
+
+func TestSQLFormatString(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	RoleUser := enum.New[Role]("user")
+
+	value, err := RoleUser.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "user", value)
+
+	var scanned Role
+	assert.EqualError(t, scanned.Scan(int64(0)), "enum WrapEnum[role]: not support type int64")
+}
+
+func TestNullableSQLColumns(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type NullRole = enum.Nullable[Role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin", 5)
+	)
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`CREATE TABLE accounts (
+		id INTEGER PRIMARY KEY,
+		code INTEGER,
+		name TEXT
+	);`)
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`INSERT INTO accounts (code, name) VALUES (5, 'user'), (NULL, NULL), (9, 'guest')`)
+	assert.NoError(t, err)
+
+	// Integer columns are scanned even though Role is stored as strings.
+	var code, name NullRole
+	assert.NoError(t, db.QueryRow(`SELECT code, name FROM accounts WHERE id = 1`).Scan(&code, &name))
+	assert.Equal(t, enum.Some(RoleAdmin), code)
+	assert.Equal(t, enum.Some(RoleUser), name)
+
+	assert.NoError(t, db.QueryRow(`SELECT code, name FROM accounts WHERE id = 2`).Scan(&code, &name))
+	assert.Equal(t, enum.Null[Role](), code)
+	assert.Equal(t, enum.Null[Role](), name)
+
+	code, name = NullRole{}, NullRole{}
+	err = db.QueryRow(`SELECT code FROM accounts WHERE id = 3`).Scan(&code)
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: unknown number 9")
+	assert.False(t, code.Valid)
+
+	err = db.QueryRow(`SELECT name FROM accounts WHERE id = 3`).Scan(&name)
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: unknown string guest")
+	assert.False(t, name.Valid)
+
+	// Values follow SetSQLFormat.
+	value, err := enum.Some(RoleAdmin).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", value)
+
+	value, err = enum.Null[Role]().Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+// sqlLevel is an enum stored in SQL as hundreds, e.g. 200 for the second level.
+type sqlLevel int
+
+func (l *sqlLevel) Scan(a any) error {
+	n, ok := a.(int64)
+	if !ok {
+		return enum.ScanSQL(a, l)
+	}
+
+	level, ok := enum.From[sqlLevel](n / 100)
+	if !ok {
+		return fmt.Errorf("unknown level %d", n)
+	}
+
+	*l = level
+	return nil
+}
+
+func TestNullableScanDelegation(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		LevelLow  = enum.New[sqlLevel]("low", 1)
+		LevelHigh = enum.New[sqlLevel]("high", 2)
+
+		RoleUser = enum.New[Role]("user")
+	)
+
+	var level enum.Nullable[sqlLevel]
+	assert.NoError(t, level.Scan(int64(200)))
+	assert.Equal(t, enum.Some(LevelHigh), level)
+
+	assert.NoError(t, level.Scan("low"))
+	assert.Equal(t, enum.Some(LevelLow), level)
+
+	assert.ErrorContains(t, level.Scan(int64(2)), "unknown level 2")
+	assert.Equal(t, enum.Some(LevelLow), level)
+
+	var roles enum.Nullable[enum.CSVSlice[Role]]
+	assert.NoError(t, roles.Scan("user"))
+	assert.Equal(t, enum.Some(enum.CSVSlice[Role]{RoleUser}), roles)
+
+	assert.ErrorContains(t, roles.Scan(int64(0)), "CSVSlice does not support type int64")
+}
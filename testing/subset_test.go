@@ -0,0 +1,107 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestSubsetWrap(t *testing.T) {
+	type Role int
+	type assignableRoles struct{}
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleGuest = enum.New[Role]("guest")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	AssignableRoles := enum.DeclareSubsetType[assignableRoles](RoleUser, RoleGuest)
+
+	assign := func(m enum.Member[assignableRoles, Role]) Role { return m.Unwrap() }
+
+	member, err := AssignableRoles.Wrap(RoleGuest)
+	assert.NoError(t, err)
+	assert.True(t, member.IsValid())
+	assert.Equal(t, RoleGuest, assign(member))
+	assert.Equal(t, "guest", member.String())
+
+	member, err = AssignableRoles.Wrap(RoleAdmin)
+	assert.EqualError(t, err, "enum Role: admin is not in subset assignableRoles")
+	assert.False(t, member.IsValid())
+
+	_, err = AssignableRoles.Wrap(Role(42))
+	assert.EqualError(t, err, "enum Role: invalid value 42 is not in subset assignableRoles")
+
+	assert.True(t, AssignableRoles.Contains(RoleUser))
+	assert.False(t, AssignableRoles.Contains(RoleAdmin))
+	assert.Equal(t, []Role{RoleUser, RoleGuest}, AssignableRoles.Values())
+
+	assert.False(t, enum.Member[assignableRoles, Role]{}.IsValid())
+}
+
+func TestSubsetDeclarePanics(t *testing.T) {
+	type Role int
+	type subsetA struct{}
+	type subsetB struct{}
+	type subsetC struct{}
+
+	var (
+		RoleUser = enum.New[Role]("user")
+	)
+
+	enum.DeclareSubsetType[subsetA](RoleUser)
+	assert.PanicsWithValue(t, "enum Role: subset subsetA was already declared", func() {
+		enum.DeclareSubsetType[subsetA](RoleUser)
+	})
+
+	assert.PanicsWithValue(t, "enum Role (42): subset subsetB contains an invalid value", func() {
+		enum.DeclareSubsetType[subsetB](RoleUser, Role(42))
+	})
+
+	assert.PanicsWithValue(t, "enum Role (0): subset subsetC contains a value twice", func() {
+		enum.DeclareSubsetType[subsetC](RoleUser, RoleUser)
+	})
+}
+
+func TestSubsetMemberJSON(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+	type assignableRoles struct{}
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleGuest = enum.New[Role]("guest")
+		_         = enum.New[Role]("admin")
+	)
+
+	AssignableRoles := enum.DeclareSubsetType[assignableRoles](RoleUser, RoleGuest)
+
+	type Request struct {
+		Role enum.Member[assignableRoles, Role] `json:"role"`
+	}
+
+	member, err := AssignableRoles.Wrap(RoleUser)
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(Request{Role: member})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"role":"user"}`, string(data))
+
+	var req Request
+	assert.NoError(t, json.Unmarshal([]byte(`{"role":"guest"}`), &req))
+	assert.Equal(t, RoleGuest, req.Role.Unwrap())
+
+	// A valid enum outside of the subset.
+	err = json.Unmarshal([]byte(`{"role":"admin"}`), &req)
+	assert.EqualError(t, err, "enum WrapEnum[role]: admin is not in subset assignableRoles")
+	assert.Equal(t, RoleGuest, req.Role.Unwrap())
+
+	err = json.Unmarshal([]byte(`{"role":"moderator"}`), &req)
+	assert.EqualError(t, err, "enum WrapEnum[role]: unknown string moderator")
+
+	_, err = json.Marshal(Request{})
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: zero member of subset assignableRoles")
+}
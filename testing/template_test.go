@@ -0,0 +1,94 @@
+package testing_test
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	type TemplateRole int
+
+	var (
+		_ = enum.New[TemplateRole]("user")
+		_ = enum.New[TemplateRole]("admin")
+	)
+
+	tmpl := template.Must(template.New("roles").Funcs(enum.TemplateFuncs()).Parse(
+		`{{range enumAll "TemplateRole"}}{{enumString .}};{{end}}{{enumString (enumFrom "TemplateRole" "admin")}}`))
+
+	var buf strings.Builder
+	assert.NoError(t, tmpl.Execute(&buf, nil))
+	assert.Equal(t, "user;admin;admin", buf.String())
+
+	htmpl := htmltemplate.Must(htmltemplate.New("roles").Funcs(enum.TemplateFuncs()).Parse(
+		`{{range enumAll "TemplateRole"}}<option>{{enumString .}}</option>{{end}}`))
+
+	buf.Reset()
+	assert.NoError(t, htmpl.Execute(&buf, nil))
+	assert.Equal(t, "<option>user</option><option>admin</option>", buf.String())
+}
+
+func TestTemplateFuncsErrors(t *testing.T) {
+	type TemplateLevel int
+
+	var (
+		_ = enum.New[TemplateLevel]("low")
+	)
+
+	execute := func(text string, data any) error {
+		tmpl := template.Must(template.New("test").Funcs(enum.TemplateFuncs()).Parse(text))
+		return tmpl.Execute(&strings.Builder{}, data)
+	}
+
+	assert.ErrorContains(t, execute(`{{enumAll "TemplateUnknown"}}`, nil), "enum TemplateUnknown: unknown enum type")
+	assert.ErrorContains(t, execute(`{{enumFrom "TemplateLevel" "high"}}`, nil), "enum TemplateLevel: unknown string high")
+	assert.ErrorContains(t, execute(`{{enumString .}}`, 42), "enum: int is not an enum type")
+	assert.ErrorContains(t, execute(`{{enumString .}}`, TemplateLevel(9)), "enum TemplateLevel: invalid value 9")
+
+	{
+		type TemplateDup int
+		enum.New[TemplateDup]("a")
+	}
+	{
+		type TemplateDup int
+		enum.New[TemplateDup]("b")
+	}
+	assert.ErrorContains(t, execute(`{{enumAll "TemplateDup"}}`, nil), "enum TemplateDup: ambiguous name")
+}
+
+func TestTemplateFuncsParseOptions(t *testing.T) {
+	type TemplateStatus int
+
+	var (
+		_ = enum.New[TemplateStatus]("active")
+		_ = enum.New[TemplateStatus]("closed")
+	)
+
+	enum.SetParseOptions[TemplateStatus](enum.TrimSpace(), enum.CaseInsensitive())
+
+	execute := func(text string) (string, error) {
+		var buf strings.Builder
+		tmpl := template.Must(template.New("test").Funcs(enum.TemplateFuncs()).Parse(text))
+		err := tmpl.Execute(&buf, nil)
+		return buf.String(), err
+	}
+
+	// Template input is resolved as by ParseString.
+	out, err := execute(`{{enumString (enumFrom "TemplateStatus" " Closed ")}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, "closed", out)
+
+	_, err = execute(`{{enumFrom "TemplateStatus" "open"}}`)
+	assert.ErrorIs(t, err, enum.ErrUnknownString)
+
+	var parseErr *enum.ParseError
+	if assert.ErrorAs(t, err, &parseErr) {
+		assert.Equal(t, "open", parseErr.Input)
+		assert.Equal(t, []string{"active", "closed"}, parseErr.Allowed)
+	}
+}
@@ -0,0 +1,93 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestTOMLConfig(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	type Config struct {
+		Name    string `toml:"name"`
+		Role    Role   `toml:"role"`
+		Default []Role `toml:"default"`
+	}
+
+	var config Config
+	assert.NoError(t, toml.Unmarshal([]byte(`
+name = "api"
+role = "admin"
+default = ["user"]
+`), &config))
+	assert.Equal(t, Config{Name: "api", Role: RoleAdmin, Default: []Role{RoleUser}}, config)
+
+	data, err := toml.Marshal(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "name = 'api'\nrole = 'admin'\ndefault = ['user']\n", string(data))
+
+	err = toml.Unmarshal([]byte(`role = "moderator"`), &config)
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: unknown string moderator")
+}
+
+func TestSafeEnumTOMLConfig(t *testing.T) {
+	type role any
+	type Role = enum.SafeEnum[role]
+
+	var RoleAdmin = enum.New[Role]("admin")
+
+	type Config struct {
+		Role Role `toml:"role"`
+	}
+
+	var config Config
+	assert.NoError(t, toml.Unmarshal([]byte(`role = "admin"`), &config))
+	assert.Equal(t, RoleAdmin, config.Role)
+
+	data, err := toml.Marshal(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "role = 'admin'\n", string(data))
+}
+
+func TestWrapperTOMLMethods(t *testing.T) {
+	type role any
+
+	var (
+		r1 = enum.New[enum.WrapEnum[role]]("user")
+		r2 = enum.New[enum.WrapUintEnum[role]]("user")
+		r3 = enum.New[enum.WrapFloatEnum[role]]("user")
+		r4 = enum.New[enum.SafeEnum[role]]("user")
+	)
+
+	for _, m := range []interface{ MarshalTOML() ([]byte, error) }{r1, r2, r3, r4} {
+		data, err := m.MarshalTOML()
+		assert.NoError(t, err)
+		assert.Equal(t, `"user"`, string(data))
+	}
+
+	var d1 enum.WrapEnum[role]
+	var d2 enum.WrapUintEnum[role]
+	var d3 enum.WrapFloatEnum[role]
+	var d4 enum.SafeEnum[role]
+
+	assert.NoError(t, d1.UnmarshalTOML("user"))
+	assert.NoError(t, d2.UnmarshalTOML("user"))
+	assert.NoError(t, d3.UnmarshalTOML("user"))
+	assert.NoError(t, d4.UnmarshalTOML("user"))
+	assert.Equal(t, r1, d1)
+	assert.Equal(t, r2, d2)
+	assert.Equal(t, r3, d3)
+	assert.Equal(t, r4, d4)
+
+	assert.EqualError(t, d4.UnmarshalTOML("moderator"), "enum SafeEnum[role]: unknown string moderator")
+	assert.EqualError(t, d4.UnmarshalTOML(int64(1)), "enum SafeEnum[role]: only supports string in toml enum")
+}
@@ -0,0 +1,139 @@
+package testing_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestTransitions(t *testing.T) {
+	type Status int
+
+	var (
+		StatusPending = enum.New[Status]("pending")
+		StatusActive  = enum.New[Status]("active")
+		StatusClosed  = enum.New[Status]("closed")
+	)
+
+	assert.EqualError(t, enum.ValidateTransition(StatusPending, StatusActive),
+		"enum Status: transitions are not defined")
+
+	enum.DefineTransitions(map[Status][]Status{
+		StatusPending: {StatusActive, StatusClosed},
+		StatusActive:  {StatusClosed},
+		StatusClosed:  {},
+	})
+
+	assert.True(t, enum.CanTransition(StatusPending, StatusActive))
+	assert.True(t, enum.CanTransition(StatusActive, StatusClosed))
+	assert.False(t, enum.CanTransition(StatusClosed, StatusPending))
+	assert.False(t, enum.CanTransition(StatusActive, StatusActive))
+	assert.False(t, enum.CanTransition(StatusPending, Status(42)))
+
+	assert.Equal(t, []Status{StatusActive, StatusClosed}, enum.NextStates(StatusPending))
+	assert.Empty(t, enum.NextStates(StatusClosed))
+	assert.Empty(t, enum.NextStates(Status(42)))
+
+	assert.NoError(t, enum.ValidateTransition(StatusPending, StatusClosed))
+	assert.EqualError(t, enum.ValidateTransition(StatusClosed, StatusPending),
+		"enum Status: cannot transition from closed to pending")
+
+	assert.Equal(t, []Status{StatusPending}, enum.UnreachableStates[Status]())
+
+	assert.PanicsWithValue(t, "enum Status: transitions were already defined", func() {
+		enum.DefineTransitions(map[Status][]Status{StatusClosed: {StatusPending}})
+	})
+}
+
+func TestTransitionsUnreachableStates(t *testing.T) {
+	type Status int
+
+	var (
+		StatusPending  = enum.New[Status]("pending")
+		StatusActive   = enum.New[Status]("active")
+		StatusArchived = enum.New[Status]("archived")
+		StatusClosed   = enum.New[Status]("closed")
+	)
+
+	enum.DefineTransitions(map[Status][]Status{
+		StatusPending:  {StatusActive},
+		StatusActive:   {StatusClosed, StatusActive},
+		StatusArchived: {StatusArchived},
+	})
+
+	assert.Equal(t, []Status{StatusPending, StatusArchived}, enum.UnreachableStates[Status]())
+}
+
+func TestDefineTransitionsInvalidState(t *testing.T) {
+	type Status int
+
+	var StatusPending = enum.New[Status]("pending")
+
+	assert.PanicsWithValue(t, "enum Status (42): cannot define transitions of an invalid state", func() {
+		enum.DefineTransitions(map[Status][]Status{42: {StatusPending}})
+	})
+
+	assert.PanicsWithValue(t, "enum Status (42): cannot define a transition from pending to an invalid state", func() {
+		enum.DefineTransitions(map[Status][]Status{StatusPending: {42}})
+	})
+
+	assert.False(t, enum.CanTransition(StatusPending, 42))
+}
+
+func TestTransitionsRequireAllStates(t *testing.T) {
+	type Status int
+
+	var (
+		StatusPending = enum.New[Status]("pending")
+		StatusActive  = enum.New[Status]("active")
+	)
+
+	enum.DefineTransitions(map[Status][]Status{
+		StatusPending: {StatusActive},
+	}, enum.RequireAllStates())
+
+	enum.New[Status]("closed")
+
+	assert.PanicsWithValue(t, "enum Status: transitions of states active, closed are not defined", func() {
+		enum.Finalize[Status]()
+	})
+}
+
+func TestTransitionsRequireAllStatesFinalized(t *testing.T) {
+	type Status int
+
+	var (
+		StatusPending = enum.New[Status]("pending")
+		StatusClosed  = enum.New[Status]("closed")
+	)
+
+	enum.Finalize[Status]()
+
+	assert.NotPanics(t, func() {
+		enum.DefineTransitions(map[Status][]Status{
+			StatusPending: {StatusClosed},
+			StatusClosed:  {},
+		}, enum.RequireAllStates())
+	})
+}
+
+func TestTransitionsDOT(t *testing.T) {
+	type Status int
+
+	var (
+		StatusPending = enum.New[Status]("pending")
+		StatusActive  = enum.New[Status]("active")
+		StatusClosed  = enum.New[Status]("closed")
+	)
+
+	enum.DefineTransitions(map[Status][]Status{
+		StatusPending: {StatusActive, StatusClosed},
+		StatusActive:  {StatusClosed},
+	})
+
+	golden, err := os.ReadFile("testdata/transitions.dot")
+	assert.NoError(t, err)
+	assert.Equal(t, string(golden), enum.TransitionsDOT[Status]())
+}
@@ -0,0 +1,125 @@
+package testing_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xybor-x/enum"
+)
+
+func TestUsageReport(t *testing.T) {
+	enum.TrackUsage(true)
+	defer enum.TrackUsage(false)
+
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+		RoleGuest = enum.New[Role]("guest")
+		RoleMod   = enum.New[Role]("mod")
+	)
+
+	assert.Equal(t, []Role{RoleUser, RoleAdmin, RoleGuest, RoleMod}, enum.UsageReport[Role]())
+
+	_, ok := enum.FromString[Role]("user")
+	assert.True(t, ok)
+	assert.Equal(t, []Role{RoleAdmin, RoleGuest, RoleMod}, enum.UsageReport[Role]())
+
+	_, err := enum.MarshalJSON(RoleAdmin)
+	assert.NoError(t, err)
+	assert.Equal(t, []Role{RoleGuest, RoleMod}, enum.UsageReport[Role]())
+
+	var r Role
+	assert.NoError(t, enum.ScanSQL("mod", &r))
+	assert.Equal(t, []Role{RoleGuest}, enum.UsageReport[Role]())
+
+	// Invalid values and unknown representations are not tracked.
+	_, ok = enum.FromString[Role]("moderator")
+	assert.False(t, ok)
+	_, err = enum.MarshalJSON(Role(42))
+	assert.Error(t, err)
+	assert.Equal(t, []Role{RoleGuest}, enum.UsageReport[Role]())
+}
+
+func TestUsageReportDisabled(t *testing.T) {
+	type Role int
+
+	var (
+		RoleUser = enum.New[Role]("user")
+	)
+
+	_ = enum.ToString(RoleUser)
+	assert.Equal(t, []Role{RoleUser}, enum.UsageReport[Role]())
+
+	enum.TrackUsage(true)
+	defer enum.TrackUsage(false)
+
+	_ = enum.ToString(RoleUser)
+	assert.Nil(t, enum.UsageReport[Role]())
+}
+
+func TestUsageWrapEnum(t *testing.T) {
+	enum.TrackUsage(true)
+	defer enum.TrackUsage(false)
+
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleUser = enum.New[Role]("user")
+		_        = enum.New[Role]("admin")
+	)
+
+	type User struct {
+		Role Role `json:"role"`
+	}
+
+	var u User
+	assert.NoError(t, json.Unmarshal([]byte(`{"role":"admin"}`), &u))
+	assert.Equal(t, []Role{RoleUser}, enum.UsageReport[Role]())
+}
+
+func TestExportUsage(t *testing.T) {
+	enum.TrackUsage(true)
+	defer enum.TrackUsage(false)
+
+	type Role int
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+		_         = enum.New[Role]("guest")
+	)
+
+	_ = enum.ToString(RoleUser)
+	_ = enum.MustFromString[Role]("admin")
+	_ = enum.ToString(RoleAdmin)
+
+	data, err := enum.ExportUsage[Role]()
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"type":"Role","values":{`+
+			`"admin":{"resolved":true,"marshaled":true},`+
+			`"guest":{"resolved":false,"marshaled":false},`+
+			`"user":{"resolved":false,"marshaled":true}}}`,
+		string(data))
+
+	var record enum.UsageRecord
+	assert.NoError(t, json.Unmarshal(data, &record))
+
+	record.Merge(enum.UsageRecord{
+		Type: "Role",
+		Values: map[string]enum.ValueUsage{
+			"guest": {Resolved: true},
+			"user":  {Resolved: true},
+		},
+	})
+
+	assert.Equal(t, map[string]enum.ValueUsage{
+		"admin": {Resolved: true, Marshaled: true},
+		"guest": {Resolved: true, Marshaled: false},
+		"user":  {Resolved: true, Marshaled: true},
+	}, record.Values)
+}
@@ -1,6 +1,9 @@
 package testing_test
 
 import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
 	"encoding/json"
 	"encoding/xml"
 	"testing"
@@ -392,3 +395,563 @@ func TestWrapEnumUnmarshalYAML(t *testing.T) {
 	err = yaml.Unmarshal([]byte("role:\n- user\n"), &data)
 	assert.ErrorContains(t, err, "enum WrapEnum[role]: only supports scalar in yaml enum")
 }
+
+func TestWrapEnumAcceptNumbersFloat(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	enum.AcceptLegacyNumbers[Role](true)
+
+	var r Role
+	assert.NoError(t, json.Unmarshal([]byte(`1`), &r))
+	assert.Equal(t, RoleAdmin, r)
+
+	assert.NoError(t, json.Unmarshal([]byte(`1.0`), &r))
+	assert.Equal(t, RoleAdmin, r)
+
+	assert.NoError(t, json.Unmarshal([]byte(`0e3`), &r))
+	assert.Equal(t, RoleUser, r)
+
+	assert.NoError(t, json.Unmarshal([]byte(`"user"`), &r))
+	assert.Equal(t, RoleUser, r)
+
+	assert.EqualError(t, enum.UnmarshalJSON([]byte(`0.5`), &r), "enum WrapEnum[role]: unknown number 0.5")
+	assert.EqualError(t, enum.UnmarshalJSON([]byte(`-`), &r), "enum WrapEnum[role]: invalid number -")
+
+	// Null is the zero value, unless SetJSONNullIsError is enabled.
+	r = RoleAdmin
+	assert.NoError(t, enum.UnmarshalJSON([]byte(`null`), &r))
+	assert.Equal(t, Role(0), r)
+
+	enum.SetJSONNullIsError[Role](true)
+	assert.EqualError(t, enum.UnmarshalJSON([]byte(`null`), &r), "enum WrapEnum[role]: invalid string null")
+}
+
+func TestWrapFloatEnumAcceptNumbers(t *testing.T) {
+	type ratio any
+	type Ratio = enum.WrapFloatEnum[ratio]
+
+	var (
+		RatioHalf = enum.New[Ratio](0.5, "half")
+		RatioFull = enum.New[Ratio](1.0, "full")
+	)
+
+	enum.AcceptLegacyNumbers[Ratio](true)
+
+	var r Ratio
+	assert.NoError(t, json.Unmarshal([]byte(`0.5`), &r))
+	assert.Equal(t, RatioHalf, r)
+
+	assert.NoError(t, json.Unmarshal([]byte(`1`), &r))
+	assert.Equal(t, RatioFull, r)
+
+	assert.EqualError(t, enum.UnmarshalJSON([]byte(`0.25`), &r), "enum WrapFloatEnum[ratio]: unknown number 0.25")
+}
+
+func TestWrapEnumUnmarshalYAMLNumber(t *testing.T) {
+	type role int
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+		RoleGuest = enum.Map(Role(7), "404")
+	)
+
+	type Test struct {
+		Role Role `yaml:"role"`
+	}
+	var data Test
+
+	err := yaml.Unmarshal([]byte("role: 1"), &data)
+	assert.NoError(t, err)
+	assert.Equal(t, RoleAdmin, data.Role)
+
+	err = yaml.Unmarshal([]byte("role: 0.0"), &data)
+	assert.NoError(t, err)
+	assert.Equal(t, RoleUser, data.Role)
+
+	// The string representation is used as written for a number which is
+	// not the numeric representation of any value.
+	err = yaml.Unmarshal([]byte("role: 404"), &data)
+	assert.NoError(t, err)
+	assert.Equal(t, RoleGuest, data.Role)
+
+	// A quoted scalar is a string.
+	err = yaml.Unmarshal([]byte(`role: "1"`), &data)
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: unknown string 1")
+
+	err = yaml.Unmarshal([]byte("role: user"), &data)
+	assert.NoError(t, err)
+	assert.Equal(t, RoleUser, data.Role)
+
+	err = yaml.Unmarshal([]byte("role: 42"), &data)
+	assert.EqualError(t, err, "enum WrapEnum[role]: unknown number 42")
+
+	err = yaml.Unmarshal([]byte("role: 1.5"), &data)
+	assert.EqualError(t, err, "enum WrapEnum[role]: unknown number 1.5")
+}
+
+func TestWrapEnumAcceptLegacyNumbers(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	type User struct {
+		Name string `json:"name"`
+		Role Role   `json:"role"`
+	}
+
+	var u User
+	err := json.Unmarshal([]byte(`{"name":"old","role":1}`), &u)
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: invalid string 1")
+
+	enum.AcceptLegacyNumbers[Role](true)
+
+	// Old-format and new-format documents are decoded into the same struct.
+	assert.NoError(t, json.Unmarshal([]byte(`{"name":"old","role":1}`), &u))
+	assert.Equal(t, User{Name: "old", Role: RoleAdmin}, u)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"name":"new","role":"user"}`), &u))
+	assert.Equal(t, User{Name: "new", Role: RoleUser}, u)
+
+	// Marshal always emits the string form.
+	data, err := json.Marshal(User{Name: "old", Role: RoleAdmin})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name":"old","role":"admin"}`, string(data))
+
+	err = json.Unmarshal([]byte(`{"role":42}`), &u)
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: unknown number 42")
+
+	err = json.Unmarshal([]byte(`{"role":1.5}`), &u)
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: unknown number 1.5")
+
+	err = json.Unmarshal([]byte(`{"role":true}`), &u)
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: invalid string true")
+
+	// Revoking the option keeps accepting the string form.
+	enum.AcceptLegacyNumbers[Role](false)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"role":"admin"}`), &u))
+	assert.Equal(t, RoleAdmin, u.Role)
+
+	err = json.Unmarshal([]byte(`{"role":1}`), &u)
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: invalid string 1")
+}
+
+func TestAcceptLegacyNumbersSafeEnum(t *testing.T) {
+	type role any
+	type Role = enum.SafeEnum[role]
+
+	var (
+		_         = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	enum.AcceptLegacyNumbers[Role](true)
+
+	var r Role
+	assert.NoError(t, enum.UnmarshalJSON([]byte(`1`), &r))
+	assert.Equal(t, RoleAdmin, r)
+}
+
+func TestWrapEnumMarshalText(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	data, err := RoleAdmin.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", string(data))
+
+	data, err = json.Marshal(map[Role]int{RoleUser: 1, RoleAdmin: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"admin":2,"user":1}`, string(data))
+
+	var m map[Role]int
+	assert.NoError(t, json.Unmarshal(data, &m))
+	assert.Equal(t, map[Role]int{RoleUser: 1, RoleAdmin: 2}, m)
+
+	_, err = Role(42).MarshalText()
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: invalid value")
+
+	err = json.Unmarshal([]byte(`{"moderator":3}`), &m)
+	assert.ErrorContains(t, err, "enum WrapEnum[role]: unknown string moderator")
+
+	var r Role
+	assert.NoError(t, r.UnmarshalText([]byte("user")))
+	assert.Equal(t, RoleUser, r)
+	assert.EqualError(t, r.UnmarshalText([]byte("moderator")), "enum WrapEnum[role]: unknown string moderator")
+}
+
+func TestWrapUintEnumMarshalText(t *testing.T) {
+	type role any
+	type Role = enum.WrapUintEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	data, err := RoleAdmin.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", string(data))
+
+	data, err = json.Marshal(map[Role]int{RoleUser: 1, RoleAdmin: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"admin":2,"user":1}`, string(data))
+
+	var m map[Role]int
+	assert.NoError(t, json.Unmarshal(data, &m))
+	assert.Equal(t, map[Role]int{RoleUser: 1, RoleAdmin: 2}, m)
+
+	_, err = Role(42).MarshalText()
+	assert.ErrorContains(t, err, "enum WrapUintEnum[role]: invalid value")
+
+	err = json.Unmarshal([]byte(`{"moderator":3}`), &m)
+	assert.ErrorContains(t, err, "enum WrapUintEnum[role]: unknown string moderator")
+
+	var r Role
+	assert.NoError(t, r.UnmarshalText([]byte("user")))
+	assert.Equal(t, RoleUser, r)
+	assert.EqualError(t, r.UnmarshalText([]byte("moderator")), "enum WrapUintEnum[role]: unknown string moderator")
+}
+
+func TestWrapFloatEnumMarshalText(t *testing.T) {
+	type role any
+	type Role = enum.WrapFloatEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	data, err := RoleAdmin.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", string(data))
+
+	data, err = json.Marshal(map[Role]int{RoleUser: 1, RoleAdmin: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"admin":2,"user":1}`, string(data))
+
+	var m map[Role]int
+	assert.NoError(t, json.Unmarshal(data, &m))
+	assert.Equal(t, map[Role]int{RoleUser: 1, RoleAdmin: 2}, m)
+
+	_, err = Role(4.2).MarshalText()
+	assert.ErrorContains(t, err, "enum WrapFloatEnum[role]: invalid value")
+
+	err = json.Unmarshal([]byte(`{"moderator":3}`), &m)
+	assert.ErrorContains(t, err, "enum WrapFloatEnum[role]: unknown string moderator")
+
+	var r Role
+	assert.NoError(t, r.UnmarshalText([]byte("user")))
+	assert.Equal(t, RoleUser, r)
+	assert.EqualError(t, r.UnmarshalText([]byte("moderator")), "enum WrapFloatEnum[role]: unknown string moderator")
+}
+
+func TestSafeEnumMarshalText(t *testing.T) {
+	type role any
+	type Role = enum.SafeEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	data, err := RoleAdmin.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", string(data))
+
+	data, err = json.Marshal(map[Role]int{RoleUser: 1, RoleAdmin: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"admin":2,"user":1}`, string(data))
+
+	var m map[Role]int
+	assert.NoError(t, json.Unmarshal(data, &m))
+	assert.Equal(t, map[Role]int{RoleUser: 1, RoleAdmin: 2}, m)
+
+	_, err = Role{}.MarshalText()
+	assert.ErrorContains(t, err, "enum SafeEnum[role]: invalid value")
+
+	err = json.Unmarshal([]byte(`{"moderator":3}`), &m)
+	assert.ErrorContains(t, err, "enum SafeEnum[role]: unknown string moderator")
+
+	var r Role
+	assert.NoError(t, r.UnmarshalText([]byte("user")))
+	assert.Equal(t, RoleUser, r)
+	assert.EqualError(t, r.UnmarshalText([]byte("moderator")), "enum SafeEnum[role]: unknown string moderator")
+}
+
+func testYAMLMapKeys[Role comparable](t *testing.T, user, admin Role) {
+	data, err := yaml.Marshal(map[Role]int{user: 1, admin: 2})
+	assert.NoError(t, err)
+
+	var keys map[string]int
+	assert.NoError(t, yaml.Unmarshal(data, &keys))
+	assert.Equal(t, map[string]int{"user": 1, "admin": 2}, keys)
+
+	var m map[Role]int
+	assert.NoError(t, yaml.Unmarshal([]byte("user: 3\nadmin: 4\n"), &m))
+	assert.Equal(t, map[Role]int{user: 3, admin: 4}, m)
+
+	// Unknown keys fail like scalar fields.
+	var field struct{ Role Role }
+	fieldErr := yaml.Unmarshal([]byte("role: moderator\n"), &field)
+	assert.ErrorContains(t, fieldErr, "unknown string moderator")
+
+	err = yaml.Unmarshal([]byte("moderator: 5\n"), &m)
+	assert.EqualError(t, err, fieldErr.Error())
+}
+
+func TestWrapperYAMLMapKeys(t *testing.T) {
+	t.Run("WrapEnum", func(t *testing.T) {
+		type role any
+		type Role = enum.WrapEnum[role]
+
+		testYAMLMapKeys(t, enum.New[Role]("user"), enum.New[Role]("admin"))
+	})
+
+	t.Run("WrapUintEnum", func(t *testing.T) {
+		type role any
+		type Role = enum.WrapUintEnum[role]
+
+		testYAMLMapKeys(t, enum.New[Role]("user"), enum.New[Role]("admin"))
+	})
+
+	t.Run("WrapFloatEnum", func(t *testing.T) {
+		type role any
+		type Role = enum.WrapFloatEnum[role]
+
+		testYAMLMapKeys(t, enum.New[Role]("user"), enum.New[Role]("admin"))
+	})
+
+	t.Run("SafeEnum", func(t *testing.T) {
+		type role any
+		type Role = enum.SafeEnum[role]
+
+		testYAMLMapKeys(t, enum.New[Role]("user"), enum.New[Role]("admin"))
+	})
+}
+
+func TestWrapperJSONMapKeys(t *testing.T) {
+	type role any
+	type Role = enum.SafeEnum[role]
+
+	var (
+		RoleUser  = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	data, err := json.Marshal(map[Role]struct{}{RoleUser: {}, RoleAdmin: {}})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"admin":{},"user":{}}`, string(data))
+
+	var set map[Role]struct{}
+	assert.NoError(t, json.Unmarshal(data, &set))
+	assert.Equal(t, map[Role]struct{}{RoleUser: {}, RoleAdmin: {}}, set)
+
+	err = json.Unmarshal([]byte(`{"user":{},"moderator":{}}`), &set)
+	assert.ErrorContains(t, err, "enum SafeEnum[role]: unknown string moderator")
+
+	// Keys are serialized like values.
+	for _, r := range enum.All[Role]() {
+		key, err := json.Marshal(map[Role]int{r: 0})
+		assert.NoError(t, err)
+
+		value, err := json.Marshal(r)
+		assert.NoError(t, err)
+		assert.Equal(t, "{"+string(value)+":0}", string(key))
+	}
+}
+
+func TestWrapEnumGob(t *testing.T) {
+	type role any
+	type Role = enum.WrapEnum[role]
+
+	// The same roles, registered in another order by a newer release.
+	type newRole any
+	type NewRole = enum.WrapEnum[newRole]
+
+	var (
+		_         = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+
+		NewRoleAdmin = enum.New[NewRole]("admin")
+		_            = enum.New[NewRole]("user")
+	)
+
+	type Job struct {
+		Name string
+		Role Role
+	}
+
+	type NewJob struct {
+		Name string
+		Role NewRole
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, gob.NewEncoder(&buf).Encode(Job{Name: "cleanup", Role: RoleAdmin}))
+
+	data := buf.Bytes()
+
+	var job Job
+	assert.NoError(t, gob.NewDecoder(bytes.NewReader(data)).Decode(&job))
+	assert.Equal(t, Job{Name: "cleanup", Role: RoleAdmin}, job)
+
+	var newJob NewJob
+	assert.NoError(t, gob.NewDecoder(bytes.NewReader(data)).Decode(&newJob))
+	assert.Equal(t, NewJob{Name: "cleanup", Role: NewRoleAdmin}, newJob)
+	assert.Equal(t, 0, int(newJob.Role))
+
+	assert.Error(t, gob.NewEncoder(&buf).Encode(Job{Role: Role(42)}))
+}
+
+func TestWrapperGobDecodeUnknown(t *testing.T) {
+	type role any
+
+	var (
+		_ = enum.New[enum.WrapEnum[role]]("user")
+		_ = enum.New[enum.WrapUintEnum[role]]("user")
+		_ = enum.New[enum.WrapFloatEnum[role]]("user")
+		_ = enum.New[enum.SafeEnum[role]]("user")
+	)
+
+	var r1 enum.WrapEnum[role]
+	var r2 enum.WrapUintEnum[role]
+	var r3 enum.WrapFloatEnum[role]
+	var r4 enum.SafeEnum[role]
+
+	assert.EqualError(t, r1.GobDecode([]byte("moderator")), "enum WrapEnum[role]: unknown string moderator")
+	assert.EqualError(t, r2.GobDecode([]byte("moderator")), "enum WrapUintEnum[role]: unknown string moderator")
+	assert.EqualError(t, r3.GobDecode([]byte("moderator")), "enum WrapFloatEnum[role]: unknown string moderator")
+	assert.EqualError(t, r4.GobDecode([]byte("moderator")), "enum SafeEnum[role]: unknown string moderator")
+
+	assert.NoError(t, r4.GobDecode([]byte("user")))
+	data, err := r4.GobEncode()
+	assert.NoError(t, err)
+	assert.Equal(t, "user", string(data))
+}
+
+// binaryStore stores values like a Redis client, which serializes them via
+// encoding.BinaryMarshaler.
+type binaryStore map[string][]byte
+
+func (s binaryStore) Set(key string, value encoding.BinaryMarshaler) error {
+	data, err := value.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	s[key] = data
+	return nil
+}
+
+func (s binaryStore) Get(key string, value encoding.BinaryUnmarshaler) error {
+	return value.UnmarshalBinary(s[key])
+}
+
+func TestWrapperBinary(t *testing.T) {
+	type role any
+
+	var (
+		r1 = enum.New[enum.WrapEnum[role]]("admin")
+		r2 = enum.New[enum.WrapUintEnum[role]]("admin")
+		r3 = enum.New[enum.WrapFloatEnum[role]]("admin")
+		r4 = enum.New[enum.SafeEnum[role]]("admin")
+	)
+
+	store := binaryStore{}
+	assert.NoError(t, store.Set("r1", r1))
+	assert.NoError(t, store.Set("r2", r2))
+	assert.NoError(t, store.Set("r3", r3))
+	assert.NoError(t, store.Set("r4", r4))
+	assert.Equal(t, binaryStore{"r1": []byte("admin"), "r2": []byte("admin"), "r3": []byte("admin"), "r4": []byte("admin")}, store)
+
+	var d1 enum.WrapEnum[role]
+	var d2 enum.WrapUintEnum[role]
+	var d3 enum.WrapFloatEnum[role]
+	var d4 enum.SafeEnum[role]
+	assert.NoError(t, store.Get("r1", &d1))
+	assert.NoError(t, store.Get("r2", &d2))
+	assert.NoError(t, store.Get("r3", &d3))
+	assert.NoError(t, store.Get("r4", &d4))
+	assert.Equal(t, r1, d1)
+	assert.Equal(t, r2, d2)
+	assert.Equal(t, r3, d3)
+	assert.Equal(t, r4, d4)
+
+	assert.Error(t, store.Set("invalid", enum.WrapEnum[role](42)))
+	assert.EqualError(t, d4.UnmarshalBinary([]byte("moderator")), "enum SafeEnum[role]: unknown string moderator")
+}
+
+func TestMarshalBinaryRoundTrip(t *testing.T) {
+	type Role int
+
+	var (
+		_         = enum.New[Role]("user")
+		RoleAdmin = enum.New[Role]("admin")
+	)
+
+	data, err := enum.MarshalBinary(RoleAdmin)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("admin"), data)
+
+	var role Role
+	assert.NoError(t, enum.UnmarshalBinary(data, &role))
+	assert.Equal(t, RoleAdmin, role)
+
+	assert.EqualError(t, enum.UnmarshalBinary([]byte("moderator"), &role), "enum Role: unknown string moderator")
+}
+
+func TestWrapEnumUnmarshalJSONEscaped(t *testing.T) {
+	type label any
+	type Label = enum.WrapEnum[label]
+
+	var (
+		LabelA     = enum.New[Label]("adminA")
+		LabelQuote = enum.New[Label](`say "hi"`)
+		LabelSlash = enum.New[Label](`C:\temp`)
+		LabelCafe  = enum.New[Label]("café")
+	)
+
+	for input, want := range map[string]Label{
+		`"admin\u0041"`:      LabelA,
+		`"say \"hi\""`:       LabelQuote,
+		`"C:\\temp"`:         LabelSlash,
+		`"caf\u00e9"`:        LabelCafe,
+		`"caf\u00E9"`:        LabelCafe,
+		`"\u0061dmin\u0041"`: LabelA,
+	} {
+		var l Label
+		assert.NoError(t, json.Unmarshal([]byte(input), &l), input)
+		assert.Equal(t, want, l, input)
+	}
+
+	for _, l := range enum.All[Label]() {
+		data, err := json.Marshal(l)
+		assert.NoError(t, err)
+
+		var got Label
+		assert.NoError(t, json.Unmarshal(data, &got), string(data))
+		assert.Equal(t, l, got)
+	}
+
+	var l Label
+	assert.EqualError(t, enum.UnmarshalJSON([]byte(`"admin\u00"`), &l), `enum WrapEnum[label]: invalid string "admin\u00"`)
+	assert.EqualError(t, enum.UnmarshalJSON([]byte(`"admin\u0042"`), &l), "enum WrapEnum[label]: unknown string adminB")
+}
@@ -0,0 +1,164 @@
+package enum
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/xybor-x/enum/internal/core"
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+// TransitionOption customizes the transitions of DefineTransitions.
+type TransitionOption func(*transitionConfig)
+
+type transitionConfig struct {
+	requireAllStates bool
+}
+
+// RequireAllStates requires every value of the enum type to be a key of the
+// transition graph when the type is finalized, so a value added later can't be
+// forgotten. Terminal states are declared with no next state.
+func RequireAllStates() TransitionOption {
+	return func(c *transitionConfig) {
+		c.requireAllStates = true
+	}
+}
+
+// DefineTransitions defines the allowed transitions between values of the enum
+// type, mapping each state to its next states.
+//
+//	enum.DefineTransitions(map[Status][]Status{
+//		StatusPending: {StatusActive, StatusClosed},
+//		StatusActive:  {StatusClosed},
+//		StatusClosed:  {},
+//	})
+//
+// It panics if the transitions were already defined or if any state is
+// invalid.
+//
+// Note that this function is not thread-safe and should only be called during
+// initialization or other safe execution points to avoid race conditions.
+func DefineTransitions[Enum comparable](graph map[Enum][]Enum, opts ...TransitionOption) {
+	core.CheckMutable[Enum](core.MetadataMutation, "define transitions")
+
+	if registry.Get(mtkey.TransitionsDefined[Enum]()) {
+		panic(fmt.Sprintf("enum %s: transitions were already defined", TrueNameOf[Enum]()))
+	}
+
+	var config transitionConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	for from, nexts := range graph {
+		if !IsValid(from) {
+			panic(fmt.Sprintf("enum %s (%#v): cannot define transitions of an invalid state",
+				TrueNameOf[Enum](), from))
+		}
+
+		for _, to := range nexts {
+			if !IsValid(to) {
+				panic(fmt.Sprintf("enum %s (%#v): cannot define a transition from %s to an invalid state",
+					TrueNameOf[Enum](), to, ToString(from)))
+			}
+		}
+	}
+
+	for from, nexts := range graph {
+		registry.Set(mtkey.TransitionsFrom(from), slices.Clone(nexts))
+		for _, to := range nexts {
+			registry.Set(mtkey.TransitionAllowed(from, to), true)
+		}
+	}
+
+	registry.Set(mtkey.TransitionsDefined[Enum](), true)
+
+	if config.requireAllStates {
+		addFinalizeHook(func(values []Enum) {
+			var missing []string
+			for _, value := range values {
+				if _, ok := registry.Get2(mtkey.TransitionsFrom(value)); !ok {
+					missing = append(missing, ToString(value))
+				}
+			}
+
+			if len(missing) > 0 {
+				panic(fmt.Sprintf("enum %s: transitions of states %s are not defined",
+					TrueNameOf[Enum](), strings.Join(missing, ", ")))
+			}
+		})
+	}
+}
+
+// CanTransition returns true if the transition between both states is
+// allowed.
+func CanTransition[Enum any](from, to Enum) bool {
+	return registry.Get(mtkey.TransitionAllowed(from, to))
+}
+
+// NextStates returns the states which the given state can transition to, in
+// their definition order.
+func NextStates[Enum any](from Enum) []Enum {
+	return slices.Clone(registry.Get(mtkey.TransitionsFrom(from)))
+}
+
+// ValidateTransition returns an error if the transition between both states
+// is not allowed.
+func ValidateTransition[Enum any](from, to Enum) error {
+	if !registry.Get(mtkey.TransitionsDefined[Enum]()) {
+		return fmt.Errorf("enum %s: transitions are not defined", TrueNameOf[Enum]())
+	}
+
+	if !CanTransition(from, to) {
+		return fmt.Errorf("enum %s: cannot transition from %s to %s", TrueNameOf[Enum](), ToString(from), ToString(to))
+	}
+
+	return nil
+}
+
+// UnreachableStates returns the values of the enum type which no other state
+// can transition to. Initial states are expected in this list, any other value
+// likely misses a transition.
+func UnreachableStates[Enum any]() []Enum {
+	values := AllRef[Enum]()
+
+	var unreachable []Enum
+	for _, to := range values {
+		reachable := false
+		for _, from := range values {
+			if any(from) != any(to) && CanTransition(from, to) {
+				reachable = true
+				break
+			}
+		}
+
+		if !reachable {
+			unreachable = append(unreachable, to)
+		}
+	}
+
+	return unreachable
+}
+
+// TransitionsDOT returns the transitions of the enum type as a graph in the
+// DOT language, e.g. to render it with Graphviz.
+func TransitionsDOT[Enum any]() string {
+	var sb strings.Builder
+	sb.WriteString("digraph " + strconv.Quote(NameOf[Enum]()) + " {\n")
+
+	for _, value := range AllRef[Enum]() {
+		sb.WriteString("\t" + strconv.Quote(ToString(value)) + ";\n")
+	}
+
+	for _, from := range AllRef[Enum]() {
+		for _, to := range registry.Get(mtkey.TransitionsFrom(from)) {
+			sb.WriteString("\t" + strconv.Quote(ToString(from)) + " -> " + strconv.Quote(ToString(to)) + ";\n")
+		}
+	}
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
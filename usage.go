@@ -0,0 +1,122 @@
+package enum
+
+import (
+	"encoding/json"
+	"sync/atomic"
+
+	"github.com/xybor-x/enum/internal/mtkey"
+	"github.com/xybor-x/enum/registry"
+)
+
+const (
+	usageResolved uint32 = 1 << iota
+	usageMarshaled
+)
+
+var usageTracking atomic.Bool
+
+// TrackUsage enables or disables the runtime usage tracking of enum values.
+//
+// When enabled, every value records whether it has ever been resolved from one
+// of its representations (FromString, UnmarshalJSON, ScanSQL, ...) or converted
+// to one of them (ToString, MarshalJSON, ValueSQL, ...) during the process
+// lifetime. Use UsageReport or ExportUsage to find values which were never
+// seen, e.g. before retiring them.
+//
+// The tracking is disabled by default. Enabling it only costs an atomic
+// operation per lookup, and it is safe to toggle at any time.
+func TrackUsage(enabled bool) {
+	usageTracking.Store(enabled)
+}
+
+// UsageReport returns all values of the enum type which have been neither
+// resolved from nor marshaled to any representation since TrackUsage was
+// enabled.
+func UsageReport[Enum any]() []Enum {
+	var unused []Enum
+	for _, e := range AllRef[Enum]() {
+		if usageOf(e) == 0 {
+			unused = append(unused, e)
+		}
+	}
+
+	return unused
+}
+
+// ValueUsage describes how an enum value was used during the process lifetime.
+type ValueUsage struct {
+	Resolved  bool `json:"resolved"`
+	Marshaled bool `json:"marshaled"`
+}
+
+// UsageRecord is the exported usage of all values of an enum type, keyed by
+// their string representations.
+type UsageRecord struct {
+	Type   string                `json:"type"`
+	Values map[string]ValueUsage `json:"values"`
+}
+
+// Merge combines the usage of other into the record. A value is considered
+// used if it was used in any of the records, so reports collected from many
+// instances can be merged in any order.
+func (r *UsageRecord) Merge(other UsageRecord) {
+	if r.Values == nil {
+		r.Values = make(map[string]ValueUsage, len(other.Values))
+	}
+
+	for name, usage := range other.Values {
+		current := r.Values[name]
+		current.Resolved = current.Resolved || usage.Resolved
+		current.Marshaled = current.Marshaled || usage.Marshaled
+		r.Values[name] = current
+	}
+}
+
+// ExportUsage serializes the usage of all values of the enum type into JSON.
+// The output can be decoded into a UsageRecord and merged with records from
+// other instances.
+func ExportUsage[Enum any]() ([]byte, error) {
+	record := UsageRecord{
+		Type:   TrueNameOf[Enum](),
+		Values: make(map[string]ValueUsage),
+	}
+
+	for _, e := range AllRef[Enum]() {
+		usage := usageOf(e)
+		record.Values[reprOf[string](e)] = ValueUsage{
+			Resolved:  usage&usageResolved != 0,
+			Marshaled: usage&usageMarshaled != 0,
+		}
+	}
+
+	return json.Marshal(record)
+}
+
+// markUsage records that the enum value was used in the given direction. It
+// does nothing if the usage tracking is disabled.
+func markUsage[Enum any](value Enum, bit uint32) {
+	if !usageTracking.Load() {
+		return
+	}
+
+	usage, ok := registry.Get2(mtkey.EnumUsage(value))
+	if !ok {
+		return
+	}
+
+	for {
+		old := usage.Load()
+		if old&bit != 0 || usage.CompareAndSwap(old, old|bit) {
+			return
+		}
+	}
+}
+
+func usageOf[Enum any](value Enum) uint32 {
+	usage, ok := registry.Get2(mtkey.EnumUsage(value))
+	if !ok {
+		return 0
+	}
+
+	return usage.Load()
+}
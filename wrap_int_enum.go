@@ -4,6 +4,10 @@ import (
 	"database/sql/driver"
 	"encoding/xml"
 	"fmt"
+	"io"
+	"log/slog"
+	"math/rand"
+	"reflect"
 
 	"github.com/xybor-x/enum/internal/core"
 	"github.com/xybor-x/enum/internal/xreflect"
@@ -45,6 +49,62 @@ func (e *WrapEnum[underlyingEnum]) UnmarshalYAML(node *yaml.Node) error {
 	return UnmarshalYAML(node, e)
 }
 
+func (e WrapEnum[underlyingEnum]) MarshalTOML() ([]byte, error) {
+	return MarshalTOML(e)
+}
+
+func (e *WrapEnum[underlyingEnum]) UnmarshalTOML(value any) error {
+	return UnmarshalTOML(value, e)
+}
+
+func (e WrapEnum[underlyingEnum]) MarshalGQL(w io.Writer) {
+	MarshalGQL(w, e)
+}
+
+func (e *WrapEnum[underlyingEnum]) UnmarshalGQL(value any) error {
+	return UnmarshalGQL(value, e)
+}
+
+func (e WrapEnum[underlyingEnum]) MarshalText() ([]byte, error) {
+	return MarshalText(e)
+}
+
+func (e *WrapEnum[underlyingEnum]) UnmarshalText(data []byte) error {
+	return UnmarshalText(data, e)
+}
+
+func (e WrapEnum[underlyingEnum]) MarshalBinary() ([]byte, error) {
+	return MarshalBinary(e)
+}
+
+func (e *WrapEnum[underlyingEnum]) UnmarshalBinary(data []byte) error {
+	return UnmarshalBinary(data, e)
+}
+
+func (e WrapEnum[underlyingEnum]) GobEncode() ([]byte, error) {
+	return GobEncode(e)
+}
+
+func (e *WrapEnum[underlyingEnum]) GobDecode(data []byte) error {
+	return GobDecode(data, e)
+}
+
+func (e WrapEnum[underlyingEnum]) MarshalBSONValue() (byte, []byte, error) {
+	return MarshalBSONValue(e)
+}
+
+func (e *WrapEnum[underlyingEnum]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return UnmarshalBSONValue(typ, data, e)
+}
+
+func (e WrapEnum[underlyingEnum]) MarshalCBOR() ([]byte, error) {
+	return MarshalCBOR(e)
+}
+
+func (e *WrapEnum[underlyingEnum]) UnmarshalCBOR(data []byte) error {
+	return UnmarshalCBOR(data, e)
+}
+
 func (e WrapEnum[underlyingEnum]) Value() (driver.Value, error) {
 	return ValueSQL(e)
 }
@@ -53,12 +113,24 @@ func (e *WrapEnum[underlyingEnum]) Scan(a any) error {
 	return ScanSQL(a, e)
 }
 
-// Int returns the int representation of the enum. This method returns the value
-// of math.MinInt32 if the enum is invalid.
+// GormDataType returns the column type of the enum for GORM migrations (see
+// SetGormDataType).
+func (e WrapEnum[underlyingEnum]) GormDataType() string {
+	return GormDataType[WrapEnum[underlyingEnum]]()
+}
+
+// Int returns the int representation of the enum. It panics if the enum is
+// invalid, unless LegacyIntSentinel is enabled.
 //
-// DEPRECATED: directly cast the enum to int instead.
+// DEPRECATED: directly cast the enum to int instead, or use IntE or IntOr.
 func (e WrapEnum[underlyingEnum]) Int() int {
-	return MustTo[int](e)
+	return mustInt(e)
+}
+
+// IntE returns the int representation of the enum, or an error if the enum is
+// invalid.
+func (e WrapEnum[underlyingEnum]) IntE() (int, error) {
+	return intE(e)
 }
 
 // To returns the underlying representation of this enum.
@@ -70,6 +142,62 @@ func (e WrapEnum[underlyingEnum]) String() string {
 	return ToString(e)
 }
 
+// StringOr returns the string representation of the enum, or def if the enum
+// is invalid.
+func (e WrapEnum[underlyingEnum]) StringOr(def string) string {
+	if str, ok := To[string](e); ok {
+		return str
+	}
+
+	return def
+}
+
+// IntOr returns the int representation of the enum, or def if the enum is
+// invalid or has no int representation.
+func (e WrapEnum[underlyingEnum]) IntOr(def int) int {
+	if i, ok := To[int](e); ok {
+		return i
+	}
+
+	return def
+}
+
+// Enum returns the values of the enum type as serialized in JSON (see
+// OpenAPIEnum), for the schema reflector of swaggest/jsonschema-go.
+func (e WrapEnum[underlyingEnum]) Enum() []any {
+	return OpenAPIEnum[WrapEnum[underlyingEnum]]()
+}
+
+// JSONSchemaBytes returns the JSON schema of the enum type as serialized in
+// JSON, for the schema reflector of swaggest/jsonschema-go.
+func (e WrapEnum[underlyingEnum]) JSONSchemaBytes() ([]byte, error) {
+	return openAPISchemaBytes[WrapEnum[underlyingEnum]]()
+}
+
+// Generate implements quick.Generator, returning a registered value picked
+// uniformly (see the Generate function).
+func (e WrapEnum[underlyingEnum]) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(Generate[WrapEnum[underlyingEnum]](r))
+}
+
+// Compare compares the enum with another one (see the Compare function), e.g.
+// for slices.SortFunc.
+func (e WrapEnum[underlyingEnum]) Compare(other WrapEnum[underlyingEnum]) int {
+	return Compare(e, other)
+}
+
+// Less reports whether the enum sorts before another one (see the Compare
+// function).
+func (e WrapEnum[underlyingEnum]) Less(other WrapEnum[underlyingEnum]) bool {
+	return Compare(e, other) < 0
+}
+
+// LogValue implements slog.LogValuer, logging the string representation of
+// the enum (see SlogValue).
+func (e WrapEnum[underlyingEnum]) LogValue() slog.Value {
+	return SlogValue(e)
+}
+
 func (e WrapEnum[underlyingEnum]) GoString() string {
 	if !e.IsValid() {
 		return fmt.Sprintf("%d", e)
@@ -84,10 +212,10 @@ func (e WrapEnum[underlyingEnum]) newEnum(repr []any) any {
 	numeric := core.GetNumericRepresentation(repr)
 	if numeric == nil {
 		numeric = core.GetAvailableEnumValue[WrapEnum[underlyingEnum]]()
-	} else {
-		repr = core.RemoveNumericRepresentation(repr)
+		return core.MapAnyAuto(xreflect.Convert[WrapEnum[underlyingEnum]](numeric), repr)
 	}
 
+	repr = core.RemoveNumericRepresentation(repr)
 	return core.MapAny(xreflect.Convert[WrapEnum[underlyingEnum]](numeric), repr)
 }
 
@@ -4,6 +4,10 @@ import (
 	"database/sql/driver"
 	"encoding/xml"
 	"fmt"
+	"io"
+	"log/slog"
+	"math/rand"
+	"reflect"
 
 	"github.com/xybor-x/enum/internal/core"
 	"github.com/xybor-x/enum/internal/xreflect"
@@ -45,6 +49,62 @@ func (e *WrapUintEnum[underlyingEnum]) UnmarshalYAML(node *yaml.Node) error {
 	return UnmarshalYAML(node, e)
 }
 
+func (e WrapUintEnum[underlyingEnum]) MarshalTOML() ([]byte, error) {
+	return MarshalTOML(e)
+}
+
+func (e *WrapUintEnum[underlyingEnum]) UnmarshalTOML(value any) error {
+	return UnmarshalTOML(value, e)
+}
+
+func (e WrapUintEnum[underlyingEnum]) MarshalGQL(w io.Writer) {
+	MarshalGQL(w, e)
+}
+
+func (e *WrapUintEnum[underlyingEnum]) UnmarshalGQL(value any) error {
+	return UnmarshalGQL(value, e)
+}
+
+func (e WrapUintEnum[underlyingEnum]) MarshalText() ([]byte, error) {
+	return MarshalText(e)
+}
+
+func (e *WrapUintEnum[underlyingEnum]) UnmarshalText(data []byte) error {
+	return UnmarshalText(data, e)
+}
+
+func (e WrapUintEnum[underlyingEnum]) MarshalBinary() ([]byte, error) {
+	return MarshalBinary(e)
+}
+
+func (e *WrapUintEnum[underlyingEnum]) UnmarshalBinary(data []byte) error {
+	return UnmarshalBinary(data, e)
+}
+
+func (e WrapUintEnum[underlyingEnum]) GobEncode() ([]byte, error) {
+	return GobEncode(e)
+}
+
+func (e *WrapUintEnum[underlyingEnum]) GobDecode(data []byte) error {
+	return GobDecode(data, e)
+}
+
+func (e WrapUintEnum[underlyingEnum]) MarshalBSONValue() (byte, []byte, error) {
+	return MarshalBSONValue(e)
+}
+
+func (e *WrapUintEnum[underlyingEnum]) UnmarshalBSONValue(typ byte, data []byte) error {
+	return UnmarshalBSONValue(typ, data, e)
+}
+
+func (e WrapUintEnum[underlyingEnum]) MarshalCBOR() ([]byte, error) {
+	return MarshalCBOR(e)
+}
+
+func (e *WrapUintEnum[underlyingEnum]) UnmarshalCBOR(data []byte) error {
+	return UnmarshalCBOR(data, e)
+}
+
 func (e WrapUintEnum[underlyingEnum]) Value() (driver.Value, error) {
 	return ValueSQL(e)
 }
@@ -53,6 +113,12 @@ func (e *WrapUintEnum[underlyingEnum]) Scan(a any) error {
 	return ScanSQL(a, e)
 }
 
+// GormDataType returns the column type of the enum for GORM migrations (see
+// SetGormDataType).
+func (e WrapUintEnum[underlyingEnum]) GormDataType() string {
+	return GormDataType[WrapUintEnum[underlyingEnum]]()
+}
+
 // To returns the underlying representation of this enum.
 func (e WrapUintEnum[underlyingEnum]) To() underlyingEnum {
 	return MustTo[underlyingEnum](e)
@@ -62,6 +128,62 @@ func (e WrapUintEnum[underlyingEnum]) String() string {
 	return ToString(e)
 }
 
+// StringOr returns the string representation of the enum, or def if the enum
+// is invalid.
+func (e WrapUintEnum[underlyingEnum]) StringOr(def string) string {
+	if str, ok := To[string](e); ok {
+		return str
+	}
+
+	return def
+}
+
+// IntOr returns the int representation of the enum, or def if the enum is
+// invalid or has no int representation.
+func (e WrapUintEnum[underlyingEnum]) IntOr(def int) int {
+	if i, ok := To[int](e); ok {
+		return i
+	}
+
+	return def
+}
+
+// Enum returns the values of the enum type as serialized in JSON (see
+// OpenAPIEnum), for the schema reflector of swaggest/jsonschema-go.
+func (e WrapUintEnum[underlyingEnum]) Enum() []any {
+	return OpenAPIEnum[WrapUintEnum[underlyingEnum]]()
+}
+
+// JSONSchemaBytes returns the JSON schema of the enum type as serialized in
+// JSON, for the schema reflector of swaggest/jsonschema-go.
+func (e WrapUintEnum[underlyingEnum]) JSONSchemaBytes() ([]byte, error) {
+	return openAPISchemaBytes[WrapUintEnum[underlyingEnum]]()
+}
+
+// Generate implements quick.Generator, returning a registered value picked
+// uniformly (see the Generate function).
+func (e WrapUintEnum[underlyingEnum]) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(Generate[WrapUintEnum[underlyingEnum]](r))
+}
+
+// Compare compares the enum with another one (see the Compare function), e.g.
+// for slices.SortFunc.
+func (e WrapUintEnum[underlyingEnum]) Compare(other WrapUintEnum[underlyingEnum]) int {
+	return Compare(e, other)
+}
+
+// Less reports whether the enum sorts before another one (see the Compare
+// function).
+func (e WrapUintEnum[underlyingEnum]) Less(other WrapUintEnum[underlyingEnum]) bool {
+	return Compare(e, other) < 0
+}
+
+// LogValue implements slog.LogValuer, logging the string representation of
+// the enum (see SlogValue).
+func (e WrapUintEnum[underlyingEnum]) LogValue() slog.Value {
+	return SlogValue(e)
+}
+
 func (e WrapUintEnum[underlyingEnum]) GoString() string {
 	if !e.IsValid() {
 		return fmt.Sprintf("%d", e)
@@ -76,10 +198,10 @@ func (e WrapUintEnum[underlyingEnum]) newEnum(repr []any) any {
 	numeric := core.GetNumericRepresentation(repr)
 	if numeric == nil {
 		numeric = core.GetAvailableEnumValue[WrapUintEnum[underlyingEnum]]()
-	} else {
-		repr = core.RemoveNumericRepresentation(repr)
+		return core.MapAnyAuto(xreflect.Convert[WrapUintEnum[underlyingEnum]](numeric), repr)
 	}
 
+	repr = core.RemoveNumericRepresentation(repr)
 	return core.MapAny(xreflect.Convert[WrapUintEnum[underlyingEnum]](numeric), repr)
 }
 